@@ -0,0 +1,29 @@
+package audit
+
+import "proctor/internal/app/proctord/storage"
+
+// Auditor records the lifecycle of a job execution: that it was submitted,
+// and (once the job finishes) what its terminal status and captured logs
+// were.
+type Auditor interface {
+	AuditJobsExecution(jobName, executionName, jobSubmissionStatus string, jobArgs map[string]string, userEmail, parentScheduleID, capturedLogs string) (int64, error)
+	AuditJobExecutionStatus(jobExecutionID int64, status string, exitCode int, capturedLogs string) error
+}
+
+type auditor struct {
+	store storage.Store
+}
+
+func New(store storage.Store) Auditor {
+	return &auditor{store: store}
+}
+
+func (a *auditor) AuditJobsExecution(jobName, executionName, jobSubmissionStatus string, jobArgs map[string]string, userEmail, parentScheduleID, capturedLogs string) (int64, error) {
+	return a.store.InsertJobExecutionStatus(jobName, executionName, jobSubmissionStatus, jobArgs, userEmail, parentScheduleID, capturedLogs)
+}
+
+// AuditJobExecutionStatus records the terminal outcome the reconciler
+// observed for a previously-submitted execution.
+func (a *auditor) AuditJobExecutionStatus(jobExecutionID int64, status string, exitCode int, capturedLogs string) error {
+	return a.store.CompleteJobExecution(jobExecutionID, status, exitCode, capturedLogs)
+}
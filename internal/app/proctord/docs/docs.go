@@ -0,0 +1,13 @@
+package docs
+
+import (
+	"net/http"
+	"path"
+
+	"proctor/internal/app/service/infra/config"
+)
+
+// APIDocHandler serves the swagger UI landing page for proctord's API docs.
+func APIDocHandler(w http.ResponseWriter, req *http.Request) {
+	http.ServeFile(w, req, path.Join(config.DocsPath(), "index.html"))
+}
@@ -0,0 +1,26 @@
+package instrumentation
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"proctor/internal/app/proctord/utility"
+)
+
+// Wrap logs the latency of every request handled at path and returns path
+// unchanged together with a handler that wraps handler, so the pair can be
+// spread directly into mux.Router.HandleFunc. If the handler attached a
+// utility.JobExecutionIDContextKey or utility.GroupIDContextKey to the
+// request context, it is logged alongside the path so a schedule's
+// executions, or a replicas group, can be traced through the request logs.
+func Wrap(path string, handler http.HandlerFunc) (string, http.HandlerFunc) {
+	return path, func(w http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		handler(w, req)
+
+		jobExecutionID := req.Context().Value(utility.JobExecutionIDContextKey)
+		groupID := req.Context().Value(utility.GroupIDContextKey)
+		log.Printf("path=%s method=%s job_execution_id=%v group_id=%v duration=%s", path, req.Method, jobExecutionID, groupID, time.Since(start))
+	}
+}
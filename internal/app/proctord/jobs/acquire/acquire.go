@@ -0,0 +1,220 @@
+package acquire
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"proctor/internal/app/proctord/storage"
+	"proctor/internal/app/proctord/utility"
+
+	"github.com/gorilla/mux"
+	"github.com/lib/pq"
+)
+
+const (
+	// DefaultWaitTimeout is how long POST /jobs/acquire blocks for a
+	// matching job before answering 204 No Content.
+	DefaultWaitTimeout = 30 * time.Second
+	// DefaultLease is how long a claimed job may go without a heartbeat
+	// before the reaper requeues it.
+	DefaultLease = 30 * time.Second
+
+	reapInterval = 5 * time.Second
+)
+
+// Acquirer lets executor daemons long-poll for a job instead of repeatedly
+// polling GetEnabledScheduledJobs, by pairing a tag-matched wait with
+// Postgres LISTEN/NOTIFY on storage.JobsQueueNotifyChannel.
+type Acquirer interface {
+	Acquire() http.HandlerFunc
+	Heartbeat() http.HandlerFunc
+}
+
+type acquireRequest struct {
+	Tags     []string `json:"tags"`
+	WorkerID string   `json:"worker_id"`
+}
+
+type waiter struct {
+	tags  map[string]bool
+	woken chan struct{}
+	once  sync.Once
+}
+
+func newWaiter(tags []string) *waiter {
+	tagSet := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tagSet[tag] = true
+	}
+	return &waiter{tags: tagSet, woken: make(chan struct{})}
+}
+
+func (w *waiter) matches(tags []string) bool {
+	for _, tag := range tags {
+		if w.tags[tag] {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *waiter) wake() {
+	w.once.Do(func() { close(w.woken) })
+}
+
+type acquirer struct {
+	store       storage.Store
+	waitTimeout time.Duration
+	lease       time.Duration
+
+	mu      sync.Mutex
+	waiters []*waiter
+}
+
+// NewAcquirer wires an Acquirer to store and starts the background
+// goroutines that keep it alive for the lifetime of the process: one
+// dispatching LISTEN notifications to waiting HTTP requests, one reaping
+// claims abandoned by dead workers.
+func NewAcquirer(store storage.Store, listener *pq.Listener) Acquirer {
+	a := &acquirer{
+		store:       store,
+		waitTimeout: DefaultWaitTimeout,
+		lease:       DefaultLease,
+	}
+
+	go a.dispatchNotifications(listener)
+	go a.reap()
+
+	return a
+}
+
+func (a *acquirer) Acquire() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var request acquireRequest
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		// Register before the first claim attempt: a job enqueued and
+		// notified between that attempt and registering the waiter would
+		// otherwise go unnoticed until waitTimeout expired.
+		waiter := a.register(request.Tags)
+		defer a.unregister(waiter)
+
+		if queued, err := a.store.ClaimJobForTags(request.Tags, request.WorkerID); err == nil {
+			_ = json.NewEncoder(w).Encode(queued)
+			return
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		select {
+		case <-waiter.woken:
+		case <-time.After(a.waitTimeout):
+		}
+
+		queued, err := a.store.ClaimJobForTags(request.Tags, request.WorkerID)
+		if errors.Is(err, sql.ErrNoRows) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(queued)
+	}
+}
+
+func (a *acquirer) Heartbeat() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		jobID := mux.Vars(req)["id"]
+
+		var request acquireRequest
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		if err := a.store.HeartbeatClaimedJob(jobID, request.WorkerID); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (a *acquirer) register(tags []string) *waiter {
+	w := newWaiter(tags)
+
+	a.mu.Lock()
+	a.waiters = append(a.waiters, w)
+	a.mu.Unlock()
+
+	return w
+}
+
+func (a *acquirer) unregister(target *waiter) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for i, w := range a.waiters {
+		if w == target {
+			a.waiters = append(a.waiters[:i], a.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+type jobsQueueNotification struct {
+	JobID string   `json:"job_id"`
+	Tags  []string `json:"tags"`
+}
+
+// dispatchNotifications wakes every waiter whose tags overlap the notified
+// job's tags, so they retry their claim immediately instead of sleeping out
+// the rest of waitTimeout.
+func (a *acquirer) dispatchNotifications(listener *pq.Listener) {
+	for notification := range listener.Notify {
+		if notification == nil {
+			continue
+		}
+
+		var payload jobsQueueNotification
+		if err := json.Unmarshal([]byte(notification.Extra), &payload); err != nil {
+			continue
+		}
+
+		a.mu.Lock()
+		for _, w := range a.waiters {
+			if w.matches(payload.Tags) {
+				w.wake()
+			}
+		}
+		a.mu.Unlock()
+	}
+}
+
+// reap periodically requeues jobs whose claim lease expired without a
+// heartbeat, so a worker that died mid-job doesn't strand it forever.
+func (a *acquirer) reap() {
+	ticker := time.NewTicker(reapInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		_, _ = a.store.RequeueStaleClaims(a.lease)
+	}
+}
@@ -0,0 +1,27 @@
+package acquire
+
+import "testing"
+
+func TestWaiterMatches(t *testing.T) {
+	w := newWaiter([]string{"a", "b"})
+
+	if !w.matches([]string{"b", "c"}) {
+		t.Fatalf("expected waiter to match overlapping tags")
+	}
+	if w.matches([]string{"c", "d"}) {
+		t.Fatalf("expected waiter not to match disjoint tags")
+	}
+}
+
+func TestWaiterWakeIsIdempotent(t *testing.T) {
+	w := newWaiter([]string{"a"})
+
+	w.wake()
+	w.wake()
+
+	select {
+	case <-w.woken:
+	default:
+		t.Fatalf("expected woken channel to be closed")
+	}
+}
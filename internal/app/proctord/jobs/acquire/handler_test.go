@@ -0,0 +1,104 @@
+package acquire
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"proctor/internal/app/proctord/storage"
+	"proctor/internal/app/proctord/storage/postgres"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type AcquirerHandlerTestSuite struct {
+	suite.Suite
+	mockStore *storage.MockStore
+	acquirer  *acquirer
+}
+
+func (suite *AcquirerHandlerTestSuite) SetupTest() {
+	suite.mockStore = &storage.MockStore{}
+	suite.acquirer = &acquirer{
+		store:       suite.mockStore,
+		waitTimeout: 20 * time.Millisecond,
+		lease:       time.Minute,
+	}
+}
+
+func (suite *AcquirerHandlerTestSuite) TestAcquireReturnsImmediatelyClaimedJob() {
+	t := suite.T()
+
+	queued := &postgres.JobsQueue{ID: 1, JobID: "schedule-1", Tags: []string{"a"}}
+	suite.mockStore.On("ClaimJobForTags", []string{"a"}, "worker-1").Return(queued, nil).Once()
+
+	requestBody, _ := json.Marshal(acquireRequest{Tags: []string{"a"}, WorkerID: "worker-1"})
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/jobs/acquire", bytes.NewReader(requestBody))
+
+	suite.acquirer.Acquire()(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var got postgres.JobsQueue
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&got))
+	assert.Equal(t, queued.JobID, got.JobID)
+	suite.mockStore.AssertExpectations(t)
+}
+
+func (suite *AcquirerHandlerTestSuite) TestAcquireTimesOutWithNoContent() {
+	t := suite.T()
+
+	suite.mockStore.On("ClaimJobForTags", []string{"a"}, "worker-1").Return((*postgres.JobsQueue)(nil), sql.ErrNoRows).Twice()
+
+	requestBody, _ := json.Marshal(acquireRequest{Tags: []string{"a"}, WorkerID: "worker-1"})
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/jobs/acquire", bytes.NewReader(requestBody))
+
+	suite.acquirer.Acquire()(responseRecorder, req)
+
+	assert.Equal(t, http.StatusNoContent, responseRecorder.Code)
+	suite.mockStore.AssertExpectations(t)
+}
+
+func (suite *AcquirerHandlerTestSuite) TestHeartbeatSuccess() {
+	t := suite.T()
+
+	suite.mockStore.On("HeartbeatClaimedJob", "schedule-1", "worker-1").Return(nil)
+
+	requestBody, _ := json.Marshal(acquireRequest{WorkerID: "worker-1"})
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/jobs/acquire/schedule-1/heartbeat", bytes.NewReader(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "schedule-1"})
+
+	suite.acquirer.Heartbeat()(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	suite.mockStore.AssertExpectations(t)
+}
+
+func (suite *AcquirerHandlerTestSuite) TestHeartbeatStoreError() {
+	t := suite.T()
+
+	suite.mockStore.On("HeartbeatClaimedJob", "schedule-1", "worker-1").Return(errors.New("any error"))
+
+	requestBody, _ := json.Marshal(acquireRequest{WorkerID: "worker-1"})
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/jobs/acquire/schedule-1/heartbeat", bytes.NewReader(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "schedule-1"})
+
+	suite.acquirer.Heartbeat()(responseRecorder, req)
+
+	assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
+	suite.mockStore.AssertExpectations(t)
+}
+
+func TestAcquirerHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AcquirerHandlerTestSuite))
+}
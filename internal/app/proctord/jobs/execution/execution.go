@@ -0,0 +1,119 @@
+package execution
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"time"
+
+	"proctor/internal/app/service/infra/kubernetes"
+	"proctor/internal/app/service/metadata"
+	"proctor/internal/app/service/secret"
+)
+
+// ExecutionContext carries the provenance of a run that didn't originate
+// from a direct /jobs/execute call. ScheduleID is empty for manual
+// executions; the cron trigger populates it so the run can be linked back
+// to the schedule that caused it.
+type ExecutionContext struct {
+	ScheduleID  string
+	TriggerTime time.Time
+}
+
+// Executioner runs a single invocation of a proc, dispatching to whichever
+// backend its metadata.Kind names.
+type Executioner interface {
+	Execute(jobName string, jobArgs map[string]string, execCtx ExecutionContext) (string, error)
+	Status(jobExecutionName string) (string, error)
+	Logs(jobExecutionName string) (string, error)
+	ExecuteReplicas(jobName string, jobArgs map[string]string, replicas int, resources kubernetes.ResourceRequest) (groupID string, podNames []string, err error)
+	Cancel(jobExecutionName string) error
+}
+
+type executioner struct {
+	kubeClient    kubernetes.Client
+	metadataStore metadata.Store
+	secretsStore  secret.Store
+	remote        *remoteExecutioner
+}
+
+func NewExecutioner(kubeClient kubernetes.Client, metadataStore metadata.Store, secretsStore secret.Store) Executioner {
+	return &executioner{
+		kubeClient:    kubeClient,
+		metadataStore: metadataStore,
+		secretsStore:  secretsStore,
+		remote:        newRemoteExecutioner(),
+	}
+}
+
+// Execute looks up the job's metadata and runs it either as a Kubernetes Job
+// (metadata.KindContainer, the default) or as an HTTP webhook invocation
+// (metadata.KindRemote).
+func (e *executioner) Execute(jobName string, jobArgs map[string]string, execCtx ExecutionContext) (string, error) {
+	jobMetadata, err := e.metadataStore.GetJobMetadata(jobName)
+	if err != nil {
+		return "", err
+	}
+
+	secrets, err := e.secretsStore.GetJobSecrets(jobName)
+	if err != nil {
+		return "", err
+	}
+	envVars := mergeEnv(secrets, jobArgs)
+
+	switch jobMetadata.Kind {
+	case metadata.KindRemote:
+		if err := validateRemoteMetadata(jobMetadata.Remote); err != nil {
+			return "", err
+		}
+		return e.remote.execute(jobName, jobMetadata.Remote, jobArgs)
+	case metadata.KindContainer, "":
+		return e.kubeClient.ExecuteJob(jobMetadata.ImageName, envVars, kubernetes.ResourceRequest{})
+	default:
+		return "", fmt.Errorf("unsupported job kind %q for %s", jobMetadata.Kind, jobName)
+	}
+}
+
+func (e *executioner) Status(jobExecutionName string) (string, error) {
+	if status, ok := e.remote.status(jobExecutionName); ok {
+		return status, nil
+	}
+	return e.kubeClient.JobExecutionStatus(jobExecutionName)
+}
+
+// Logs returns the captured response body for a metadata.KindRemote run, or
+// tails whatever StreamJobLogs currently has buffered for a container job
+// into a string. Called both right after submission (when a container job's
+// logs are usually still empty) and again by the reconciler once the job
+// reaches a terminal status, so the captured_logs column ends up with the
+// job's full output rather than a snapshot from the moment it started.
+func (e *executioner) Logs(jobExecutionName string) (string, error) {
+	if logs, ok := e.remote.logs(jobExecutionName); ok {
+		return logs, nil
+	}
+
+	recorder := httptest.NewRecorder()
+	if err := e.kubeClient.StreamJobLogs(jobExecutionName, recorder); err != nil {
+		return "", err
+	}
+	return recorder.Body.String(), nil
+}
+
+// Cancel stops a still-running execution. A remote execution's webhook call
+// isn't cancellable once dispatched, so this is a no-op rather than an error
+// for one (it'll reach a terminal status on its own once the call returns).
+func (e *executioner) Cancel(jobExecutionName string) error {
+	if e.remote.known(jobExecutionName) {
+		return nil
+	}
+	return e.kubeClient.CancelJob(jobExecutionName)
+}
+
+func mergeEnv(maps ...map[string]string) map[string]string {
+	merged := make(map[string]string)
+	for _, m := range maps {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
@@ -0,0 +1,276 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"proctor/internal/app/proctord/audit"
+	"proctor/internal/app/proctord/storage"
+	"proctor/internal/app/proctord/utility"
+	"proctor/internal/app/service/infra/kubernetes"
+	"proctor/pkg/job"
+
+	"github.com/gorilla/mux"
+)
+
+// ExecutionHandler exposes the HTTP handlers backing /jobs/execute.
+type ExecutionHandler interface {
+	Handle() http.HandlerFunc
+	Status() http.HandlerFunc
+	Replicas() http.HandlerFunc
+	ReplicasStatus() http.HandlerFunc
+	CancelReplicas() http.HandlerFunc
+}
+
+type executionRequest struct {
+	JobName string            `json:"job_name"`
+	JobArgs map[string]string `json:"job_args"`
+}
+
+type replicasRequest struct {
+	Name               string            `json:"name"`
+	Args               map[string]string `json:"args"`
+	Replicas           int               `json:"replicas"`
+	Resources          resourcesRequest  `json:"resources"`
+	NotificationEmails string            `json:"notification_emails"`
+	Tags               string            `json:"tags"`
+}
+
+type resourcesRequest struct {
+	CPU    string `json:"cpu"`
+	Memory string `json:"memory"`
+	GPU    string `json:"gpu"`
+}
+
+type executionHandler struct {
+	auditor     audit.Auditor
+	store       storage.Store
+	executioner Executioner
+}
+
+func NewExecutionHandler(auditor audit.Auditor, store storage.Store, executioner Executioner) ExecutionHandler {
+	return &executionHandler{auditor: auditor, store: store, executioner: executioner}
+}
+
+func (h *executionHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var request executionRequest
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		userEmail := req.Header.Get(utility.UserEmailHeaderKey)
+
+		jobExecutionName, err := h.executioner.Execute(request.JobName, request.JobArgs, ExecutionContext{})
+		if err != nil {
+			if isRemoteValidationError(err) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(err.Error()))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(utility.NonExistentProcClientError))
+			return
+		}
+
+		capturedLogs, _ := h.executioner.Logs(jobExecutionName)
+
+		jobExecutionID, err := h.auditor.AuditJobsExecution(request.JobName, jobExecutionName, utility.JobSubmissionSuccess, request.JobArgs, userEmail, "", capturedLogs)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+		*req = *req.WithContext(context.WithValue(req.Context(), utility.JobExecutionIDContextKey, jobExecutionID))
+
+		guid := fmt.Sprintf("%s.%d", job.TypeExecute, jobExecutionID)
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]string{"name": jobExecutionName, "guid": guid})
+	}
+}
+
+func (h *executionHandler) Status() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		jobExecutionName := mux.Vars(req)["name"]
+
+		status, err := h.executioner.Status(jobExecutionName)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"status": status})
+	}
+}
+
+// Replicas provisions several parallel runs of the same proc in one call,
+// tagging them with a shared group ID that ReplicasStatus and CancelReplicas
+// address them by.
+func (h *executionHandler) Replicas() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var request replicasRequest
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		if request.Replicas < 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.InvalidReplicasClientError))
+			return
+		}
+
+		resources := kubernetes.ResourceRequest{
+			CPU:    request.Resources.CPU,
+			Memory: request.Resources.Memory,
+			GPU:    request.Resources.GPU,
+		}
+
+		groupID, podNames, err := h.executioner.ExecuteReplicas(request.Name, request.Args, request.Replicas, resources)
+		if err != nil && groupID == "" {
+			// Failed before any replica was provisioned (unknown proc,
+			// invalid resources, ...): nothing was started, nothing to record.
+			if isRemoteValidationError(err) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(err.Error()))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(utility.NonExistentProcClientError))
+			return
+		}
+
+		if len(podNames) > 0 {
+			if insertErr := h.store.InsertJobsGroup(groupID, request.Name, podNames); insertErr != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(utility.ServerError))
+				return
+			}
+		}
+
+		userEmail := req.Header.Get(utility.UserEmailHeaderKey)
+		for _, podName := range podNames {
+			capturedLogs, _ := h.executioner.Logs(podName)
+			_, _ = h.auditor.AuditJobsExecution(request.Name, podName, utility.JobSubmissionSuccess, request.Args, userEmail, "", capturedLogs)
+		}
+		*req = *req.WithContext(context.WithValue(req.Context(), utility.GroupIDContextKey, groupID))
+
+		if err != nil {
+			// Some replicas failed mid-fan-out: group_id/pod_names still let
+			// the caller find and cancel whatever was already provisioned
+			// instead of it being leaked with no handle on it.
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"group_id": groupID, "pod_names": podNames, "error": err.Error()})
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"group_id": groupID, "pod_names": podNames})
+	}
+}
+
+// replicaStatusBuckets are the canonical states ReplicasStatus reports
+// counts for, regardless of the raw status string a given backend returns.
+const (
+	replicaPending   = "pending"
+	replicaRunning   = "running"
+	replicaSucceeded = "succeeded"
+	replicaFailed    = "failed"
+)
+
+// normalizeReplicaStatus maps whatever Status() returns - a utility.Job*
+// constant, or a raw Kubernetes job status it didn't recognize - onto the
+// four canonical buckets ReplicasStatus promises in its counts.
+func normalizeReplicaStatus(status string) string {
+	switch status {
+	case utility.JobSucceeded:
+		return replicaSucceeded
+	case utility.JobFailed, utility.JobExecutionStatusFetchError:
+		return replicaFailed
+	case utility.JobWaiting:
+		return replicaRunning
+	default:
+		return replicaPending
+	}
+}
+
+// ReplicasStatus reports, for every pod in the group, its live status as
+// Executioner.Status returns it (from Kubernetes, or from the remote
+// executioner for a KindRemote run), aggregated into
+// pending/running/succeeded/failed counts.
+func (h *executionHandler) ReplicasStatus() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		groupID := mux.Vars(req)["group_id"]
+
+		group, err := h.store.GetJobsGroup(groupID)
+		if err != nil || len(group) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(utility.NonExistentProcClientError))
+			return
+		}
+
+		counts := map[string]int{replicaPending: 0, replicaRunning: 0, replicaSucceeded: 0, replicaFailed: 0}
+		pods := make(map[string]string, len(group))
+		for _, replica := range group {
+			status, err := h.executioner.Status(replica.PodName)
+			if err != nil {
+				status = utility.JobExecutionStatusFetchError
+			}
+			pods[replica.PodName] = status
+			counts[normalizeReplicaStatus(status)]++
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"group_id": groupID,
+			"counts":   counts,
+			"pods":     pods,
+		})
+	}
+}
+
+// CancelReplicas attempts to cancel every pod in the group - same
+// partial-failure handling as Replicas()/ExecuteReplicas, so one
+// uncancellable pod doesn't leave the rest of the group running and
+// untouched. Only when every cancel succeeded does it forget the group
+// itself; otherwise it answers 500 with the pods that failed and keeps the
+// group.
+func (h *executionHandler) CancelReplicas() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		groupID := mux.Vars(req)["group_id"]
+
+		group, err := h.store.GetJobsGroup(groupID)
+		if err != nil || len(group) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(utility.NonExistentProcClientError))
+			return
+		}
+
+		var failed []string
+		for _, replica := range group {
+			if err := h.executioner.Cancel(replica.PodName); err != nil {
+				failed = append(failed, replica.PodName)
+			}
+		}
+
+		if len(failed) > 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"group_id": groupID, "failed_pod_names": failed})
+			return
+		}
+
+		if err := h.store.RemoveJobsGroup(groupID); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+}
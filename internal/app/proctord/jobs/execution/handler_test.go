@@ -0,0 +1,88 @@
+package execution
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"proctor/internal/app/proctord/audit"
+	"proctor/internal/app/proctord/storage"
+	"proctor/internal/app/proctord/storage/postgres"
+	"proctor/internal/app/proctord/utility"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CancelReplicasTestSuite struct {
+	suite.Suite
+	mockStore       *storage.MockStore
+	mockExecutioner *MockExecutioner
+	handler         ExecutionHandler
+}
+
+func (suite *CancelReplicasTestSuite) SetupTest() {
+	suite.mockStore = &storage.MockStore{}
+	suite.mockExecutioner = &MockExecutioner{}
+	suite.handler = NewExecutionHandler(audit.New(suite.mockStore), suite.mockStore, suite.mockExecutioner)
+}
+
+func (suite *CancelReplicasTestSuite) TestCancelReplicasCancelsEveryPodAndForgetsGroup() {
+	t := suite.T()
+
+	group := []postgres.JobsGroup{{GroupID: "group-1", PodName: "pod-1"}, {GroupID: "group-1", PodName: "pod-2"}}
+	suite.mockStore.On("GetJobsGroup", "group-1").Return(group, nil)
+	suite.mockExecutioner.On("Cancel", "pod-1").Return(nil)
+	suite.mockExecutioner.On("Cancel", "pod-2").Return(nil)
+	suite.mockStore.On("RemoveJobsGroup", "group-1").Return(nil)
+
+	req := httptest.NewRequest("DELETE", "/jobs/execute/replicas/group-1", nil)
+	req = mux.SetURLVars(req, map[string]string{"group_id": "group-1"})
+	responseRecorder := httptest.NewRecorder()
+
+	suite.handler.CancelReplicas()(responseRecorder, req)
+
+	assert.Equal(t, 200, responseRecorder.Code)
+	suite.mockExecutioner.AssertExpectations(t)
+	suite.mockStore.AssertExpectations(t)
+}
+
+func (suite *CancelReplicasTestSuite) TestCancelReplicasAttemptsEveryPodAndReportsFailures() {
+	t := suite.T()
+
+	group := []postgres.JobsGroup{{GroupID: "group-1", PodName: "pod-1"}, {GroupID: "group-1", PodName: "pod-2"}}
+	suite.mockStore.On("GetJobsGroup", "group-1").Return(group, nil)
+	suite.mockExecutioner.On("Cancel", "pod-1").Return(errors.New("already gone"))
+	suite.mockExecutioner.On("Cancel", "pod-2").Return(nil)
+
+	req := httptest.NewRequest("DELETE", "/jobs/execute/replicas/group-1", nil)
+	req = mux.SetURLVars(req, map[string]string{"group_id": "group-1"})
+	responseRecorder := httptest.NewRecorder()
+
+	suite.handler.CancelReplicas()(responseRecorder, req)
+
+	assert.Equal(t, 500, responseRecorder.Code)
+	suite.mockExecutioner.AssertExpectations(t)
+	suite.mockStore.AssertNotCalled(t, "RemoveJobsGroup", "group-1")
+}
+
+func (suite *CancelReplicasTestSuite) TestCancelReplicasUnknownGroupIsNotFound() {
+	t := suite.T()
+
+	suite.mockStore.On("GetJobsGroup", "group-1").Return([]postgres.JobsGroup{}, nil)
+
+	req := httptest.NewRequest("DELETE", "/jobs/execute/replicas/group-1", nil)
+	req = mux.SetURLVars(req, map[string]string{"group_id": "group-1"})
+	responseRecorder := httptest.NewRecorder()
+
+	suite.handler.CancelReplicas()(responseRecorder, req)
+
+	assert.Equal(t, 404, responseRecorder.Code)
+	assert.Equal(t, utility.NonExistentProcClientError, responseRecorder.Body.String())
+	suite.mockExecutioner.AssertNotCalled(t, "Cancel")
+}
+
+func TestCancelReplicasTestSuite(t *testing.T) {
+	suite.Run(t, new(CancelReplicasTestSuite))
+}
@@ -0,0 +1,39 @@
+package execution
+
+import (
+	"proctor/internal/app/service/infra/kubernetes"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockExecutioner is a testify mock of Executioner, for handler tests that
+// shouldn't need a real Kubernetes/remote backend.
+type MockExecutioner struct {
+	mock.Mock
+}
+
+func (m *MockExecutioner) Execute(jobName string, jobArgs map[string]string, execCtx ExecutionContext) (string, error) {
+	arguments := m.Called(jobName, jobArgs, execCtx)
+	return arguments.String(0), arguments.Error(1)
+}
+
+func (m *MockExecutioner) Status(jobExecutionName string) (string, error) {
+	arguments := m.Called(jobExecutionName)
+	return arguments.String(0), arguments.Error(1)
+}
+
+func (m *MockExecutioner) Logs(jobExecutionName string) (string, error) {
+	arguments := m.Called(jobExecutionName)
+	return arguments.String(0), arguments.Error(1)
+}
+
+func (m *MockExecutioner) ExecuteReplicas(jobName string, jobArgs map[string]string, replicas int, resources kubernetes.ResourceRequest) (string, []string, error) {
+	arguments := m.Called(jobName, jobArgs, replicas, resources)
+	podNames, _ := arguments.Get(1).([]string)
+	return arguments.String(0), podNames, arguments.Error(2)
+}
+
+func (m *MockExecutioner) Cancel(jobExecutionName string) error {
+	arguments := m.Called(jobExecutionName)
+	return arguments.Error(0)
+}
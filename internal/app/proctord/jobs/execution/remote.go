@@ -0,0 +1,164 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"proctor/internal/app/proctord/utility"
+	"proctor/internal/app/service/metadata"
+
+	"github.com/go-resty/resty/v2"
+	uuid "github.com/google/uuid"
+)
+
+// remoteExecutioner dispatches metadata.KindRemote jobs over HTTP instead of
+// scheduling a Kubernetes pod, and keeps enough state in memory for the
+// status/logs endpoints to answer for runs it started.
+type remoteExecutioner struct {
+	client *resty.Client
+
+	mu       sync.Mutex
+	statuses map[string]string
+	jobLogs  map[string]string
+}
+
+func newRemoteExecutioner() *remoteExecutioner {
+	return &remoteExecutioner{
+		client:   resty.New(),
+		statuses: make(map[string]string),
+		jobLogs:  make(map[string]string),
+	}
+}
+
+func validateRemoteMetadata(remote *metadata.RemoteMetadata) error {
+	return metadata.ValidateRemote(remote)
+}
+
+// isRemoteValidationError reports whether err came from validateRemoteMetadata
+// rejecting a proc's remote metadata, so callers can answer 400 instead of
+// the generic proc-not-found 404 that every other Execute failure maps to.
+func isRemoteValidationError(err error) bool {
+	switch err.Error() {
+	case utility.MissingRemoteURLClientError, utility.InvalidRemoteMethodClientError:
+		return true
+	default:
+		return false
+	}
+}
+
+// maxRemoteTimeoutSeconds bounds the webhook call validateRemoteMetadata
+// otherwise lets a proc author set to anything, so one misconfigured remote
+// job can't pin a goroutine (and the HTTP connection it holds) forever.
+const maxRemoteTimeoutSeconds = 300
+
+// execute dispatches the webhook call on its own goroutine and returns
+// immediately with status JobWaiting, the same as the container path (which
+// returns as soon as the Kubernetes Job is scheduled, not once it finishes).
+// status()/logs() poll r.statuses/r.jobLogs, populated once the call
+// completes, so a caller-supplied TimeoutSeconds no longer ties up the
+// request-handling goroutine for its whole duration.
+func (r *remoteExecutioner) execute(jobName string, remote *metadata.RemoteMetadata, jobArgs map[string]string) (string, error) {
+	jobExecutionName := fmt.Sprintf("%s-%s", jobName, uuid.New().String())
+
+	r.mu.Lock()
+	r.statuses[jobExecutionName] = utility.JobWaiting
+	r.mu.Unlock()
+
+	go r.run(jobExecutionName, remote, jobArgs)
+
+	return jobExecutionName, nil
+}
+
+func (r *remoteExecutioner) run(jobExecutionName string, remote *metadata.RemoteMetadata, jobArgs map[string]string) {
+	method := strings.ToUpper(remote.Method)
+	if method == "" {
+		method = "POST"
+	}
+
+	timeoutSeconds := remote.TimeoutSeconds
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = 30
+	} else if timeoutSeconds > maxRemoteTimeoutSeconds {
+		timeoutSeconds = maxRemoteTimeoutSeconds
+	}
+	timeout := time.Duration(timeoutSeconds) * time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	request := r.client.R().
+		SetContext(ctx).
+		SetHeaders(substituteArgsInMap(remote.Headers, jobArgs)).
+		SetBody(substituteArgs(remote.Body, jobArgs))
+
+	response, err := request.Execute(method, substituteArgs(remote.URL, jobArgs))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err != nil {
+		r.statuses[jobExecutionName] = utility.JobFailed
+		r.jobLogs[jobExecutionName] = err.Error()
+		return
+	}
+
+	r.jobLogs[jobExecutionName] = string(response.Body())
+
+	if isExpectedStatusCode(response.StatusCode(), remote.ExpectedResponseCodes) {
+		r.statuses[jobExecutionName] = utility.JobSucceeded
+	} else {
+		r.statuses[jobExecutionName] = utility.JobFailed
+	}
+}
+
+func (r *remoteExecutioner) status(jobExecutionName string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	status, ok := r.statuses[jobExecutionName]
+	return status, ok
+}
+
+func (r *remoteExecutioner) logs(jobExecutionName string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	logs, ok := r.jobLogs[jobExecutionName]
+	return logs, ok
+}
+
+func (r *remoteExecutioner) known(jobExecutionName string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.statuses[jobExecutionName]
+	return ok
+}
+
+func isExpectedStatusCode(statusCode int, expected []int) bool {
+	if len(expected) == 0 {
+		return statusCode >= 200 && statusCode < 300
+	}
+	for _, code := range expected {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func substituteArgs(template string, args map[string]string) string {
+	result := template
+	for key, value := range args {
+		result = strings.ReplaceAll(result, fmt.Sprintf("{{%s}}", key), value)
+	}
+	return result
+}
+
+func substituteArgsInMap(template map[string]string, args map[string]string) map[string]string {
+	result := make(map[string]string, len(template))
+	for k, v := range template {
+		result[k] = substituteArgs(v, args)
+	}
+	return result
+}
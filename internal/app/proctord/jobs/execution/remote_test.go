@@ -0,0 +1,44 @@
+package execution
+
+import (
+	"errors"
+	"testing"
+
+	"proctor/internal/app/proctord/utility"
+	"proctor/internal/app/service/metadata"
+)
+
+func TestValidateRemoteMetadata(t *testing.T) {
+	if err := validateRemoteMetadata(nil); err == nil {
+		t.Fatalf("expected error for nil remote metadata")
+	}
+
+	if err := validateRemoteMetadata(&metadata.RemoteMetadata{URL: "http://example.com", Method: "TRACE"}); err == nil {
+		t.Fatalf("expected error for unsupported method")
+	}
+
+	if err := validateRemoteMetadata(&metadata.RemoteMetadata{URL: "http://example.com", Method: "post"}); err != nil {
+		t.Fatalf("expected valid remote metadata, got %v", err)
+	}
+}
+
+func TestIsRemoteValidationError(t *testing.T) {
+	if !isRemoteValidationError(errors.New(utility.MissingRemoteURLClientError)) {
+		t.Fatalf("expected MissingRemoteURLClientError to be a validation error")
+	}
+	if isRemoteValidationError(errors.New("some other failure")) {
+		t.Fatalf("did not expect an unrelated error to be a validation error")
+	}
+}
+
+func TestIsExpectedStatusCode(t *testing.T) {
+	if !isExpectedStatusCode(200, nil) {
+		t.Fatalf("expected 200 to be accepted with no explicit expected codes")
+	}
+	if isExpectedStatusCode(404, nil) {
+		t.Fatalf("did not expect 404 to be accepted with no explicit expected codes")
+	}
+	if !isExpectedStatusCode(404, []int{404, 200}) {
+		t.Fatalf("expected 404 to be accepted when explicitly listed")
+	}
+}
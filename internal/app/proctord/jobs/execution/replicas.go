@@ -0,0 +1,156 @@
+package execution
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"proctor/internal/app/service/infra/kubernetes"
+	"proctor/internal/app/service/metadata"
+
+	uuid "github.com/google/uuid"
+)
+
+// ExecuteReplicas provisions `replicas` parallel runs of jobName - as
+// Kubernetes Jobs, or as webhook fanouts for metadata.KindRemote procs -
+// all tagged with a freshly minted group ID so they can be queried and
+// cancelled together via /jobs/execute/replicas/{group_id}. Replicas are
+// started concurrently so a slow KindRemote endpoint can't make the whole
+// call take replicas times as long. If any replica fails, ExecuteReplicas
+// still returns groupID and the replicas that did start, so the caller can
+// record and later cancel them instead of leaking them untracked.
+func (e *executioner) ExecuteReplicas(jobName string, jobArgs map[string]string, replicas int, resources kubernetes.ResourceRequest) (string, []string, error) {
+	if replicas < 1 {
+		return "", nil, fmt.Errorf("replicas must be at least 1, got %d", replicas)
+	}
+
+	jobMetadata, err := e.metadataStore.GetJobMetadata(jobName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := validateReplicaResources(jobMetadata.ResourceLimits, replicas, resources); err != nil {
+		return "", nil, err
+	}
+
+	secrets, err := e.secretsStore.GetJobSecrets(jobName)
+	if err != nil {
+		return "", nil, err
+	}
+	envVars := mergeEnv(secrets, jobArgs)
+
+	groupID := uuid.New().String()
+	podNames := make([]string, replicas)
+	errs := make([]error, replicas)
+
+	var wg sync.WaitGroup
+	wg.Add(replicas)
+	for i := 0; i < replicas; i++ {
+		go func(i int) {
+			defer wg.Done()
+			podNames[i], errs[i] = e.executeOne(jobName, jobMetadata, jobArgs, envVars, resources)
+		}(i)
+	}
+	wg.Wait()
+
+	provisioned := make([]string, 0, replicas)
+	var firstErr error
+	for i, podName := range podNames {
+		if errs[i] != nil {
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			continue
+		}
+		provisioned = append(provisioned, podName)
+	}
+
+	return groupID, provisioned, firstErr
+}
+
+func (e *executioner) executeOne(jobName string, jobMetadata *metadata.Metadata, jobArgs, envVars map[string]string, resources kubernetes.ResourceRequest) (string, error) {
+	switch jobMetadata.Kind {
+	case metadata.KindRemote:
+		if err := validateRemoteMetadata(jobMetadata.Remote); err != nil {
+			return "", err
+		}
+		return e.remote.execute(jobName, jobMetadata.Remote, jobArgs)
+	case metadata.KindContainer, "":
+		return e.kubeClient.ExecuteJob(jobMetadata.ImageName, envVars, resources)
+	default:
+		return "", fmt.Errorf("unsupported job kind %q for %s", jobMetadata.Kind, jobName)
+	}
+}
+
+// validateReplicaResources enforces the per-image ResourceLimits a proc was
+// registered with, if any. A nil limit leaves the request unconstrained.
+func validateReplicaResources(limits *metadata.ResourceLimits, replicas int, resources kubernetes.ResourceRequest) error {
+	if limits == nil {
+		return nil
+	}
+
+	if limits.MaxReplicas > 0 && replicas > limits.MaxReplicas {
+		return fmt.Errorf("requested %d replicas exceeds the limit of %d for this proc", replicas, limits.MaxReplicas)
+	}
+
+	for _, pair := range []struct {
+		name, requested, limit string
+	}{
+		{"cpu", resources.CPU, limits.MaxCPU},
+		{"memory", resources.Memory, limits.MaxMemory},
+		{"gpu", resources.GPU, limits.MaxGPU},
+	} {
+		exceeds, err := exceedsLimit(pair.requested, pair.limit)
+		if err != nil {
+			return err
+		}
+		if exceeds {
+			return fmt.Errorf("requested %s %q exceeds the limit of %q for this proc", pair.name, pair.requested, pair.limit)
+		}
+	}
+
+	return nil
+}
+
+// exceedsLimit compares two Kubernetes-style resource quantities (plain
+// numbers, optionally suffixed with Ki/Mi/Gi) and reports whether requested
+// is greater than limit. An empty requested or limit value means "no
+// constraint" on that dimension.
+func exceedsLimit(requested, limit string) (bool, error) {
+	if requested == "" || limit == "" {
+		return false, nil
+	}
+
+	requestedValue, err := parseResourceQuantity(requested)
+	if err != nil {
+		return false, fmt.Errorf("invalid resource quantity %q", requested)
+	}
+	limitValue, err := parseResourceQuantity(limit)
+	if err != nil {
+		return false, fmt.Errorf("invalid resource limit %q", limit)
+	}
+
+	return requestedValue > limitValue, nil
+}
+
+func parseResourceQuantity(quantity string) (float64, error) {
+	multiplier := 1.0
+	switch {
+	case strings.HasSuffix(quantity, "Gi"):
+		multiplier = 1024 * 1024 * 1024
+		quantity = strings.TrimSuffix(quantity, "Gi")
+	case strings.HasSuffix(quantity, "Mi"):
+		multiplier = 1024 * 1024
+		quantity = strings.TrimSuffix(quantity, "Mi")
+	case strings.HasSuffix(quantity, "Ki"):
+		multiplier = 1024
+		quantity = strings.TrimSuffix(quantity, "Ki")
+	}
+
+	value, err := strconv.ParseFloat(quantity, 64)
+	if err != nil {
+		return 0, err
+	}
+	return value * multiplier, nil
+}
@@ -0,0 +1,66 @@
+package execution
+
+import "testing"
+
+func TestParseResourceQuantity(t *testing.T) {
+	cases := map[string]float64{
+		"2":   2,
+		"512": 512,
+		"1Ki": 1024,
+		"2Mi": 2 * 1024 * 1024,
+		"1Gi": 1024 * 1024 * 1024,
+	}
+
+	for input, want := range cases {
+		got, err := parseResourceQuantity(input)
+		if err != nil {
+			t.Fatalf("parseResourceQuantity(%q) returned error: %v", input, err)
+		}
+		if got != want {
+			t.Fatalf("parseResourceQuantity(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	if _, err := parseResourceQuantity("not-a-number"); err == nil {
+		t.Fatalf("expected an error for an invalid quantity")
+	}
+}
+
+func TestExceedsLimit(t *testing.T) {
+	exceeds, err := exceedsLimit("2Gi", "1Gi")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !exceeds {
+		t.Fatalf("expected 2Gi to exceed a 1Gi limit")
+	}
+
+	exceeds, err = exceedsLimit("512Mi", "1Gi")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if exceeds {
+		t.Fatalf("did not expect 512Mi to exceed a 1Gi limit")
+	}
+
+	exceeds, err = exceedsLimit("", "1Gi")
+	if err != nil || exceeds {
+		t.Fatalf("expected no constraint when requested is empty, got exceeds=%v err=%v", exceeds, err)
+	}
+}
+
+func TestNormalizeReplicaStatus(t *testing.T) {
+	cases := map[string]string{
+		"SUCCEEDED":                        replicaSucceeded,
+		"FAILED":                           replicaFailed,
+		"JOB_EXECUTION_STATUS_FETCH_ERROR": replicaFailed,
+		"WAITING":                          replicaRunning,
+		"SOME_UNRECOGNIZED_RAW_K8S_STATUS": replicaPending,
+	}
+
+	for status, want := range cases {
+		if got := normalizeReplicaStatus(status); got != want {
+			t.Fatalf("normalizeReplicaStatus(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package logs
+
+import (
+	"net/http"
+
+	"proctor/internal/app/proctord/utility"
+	"proctor/internal/app/service/infra/kubernetes"
+)
+
+type Logger interface {
+	Stream() http.HandlerFunc
+}
+
+// RemoteLogProvider is satisfied by execution.Executioner and lets Logger
+// surface the captured response body of a metadata.KindRemote run, since
+// those never have a pod for StreamJobLogs to tail.
+type RemoteLogProvider interface {
+	Logs(jobExecutionName string) (string, error)
+}
+
+type logger struct {
+	kubeClient kubernetes.Client
+	remote     RemoteLogProvider
+}
+
+func NewLogger(kubeClient kubernetes.Client, remote RemoteLogProvider) Logger {
+	return &logger{kubeClient: kubeClient, remote: remote}
+}
+
+func (l *logger) Stream() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		jobName := req.URL.Query().Get("job_name")
+		if jobName == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		if logs, err := l.remote.Logs(jobName); err == nil {
+			_, _ = w.Write([]byte(logs))
+			return
+		}
+
+		if err := l.kubeClient.StreamJobLogs(jobName, w); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+	}
+}
+
+// WriteCaptured writes out logs captured from a run that has already
+// finished (e.g. a past schedule execution), as opposed to Stream which
+// tails a currently running job.
+func WriteCaptured(w http.ResponseWriter, capturedLogs string) {
+	_, _ = w.Write([]byte(capturedLogs))
+}
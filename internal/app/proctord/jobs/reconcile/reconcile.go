@@ -0,0 +1,106 @@
+// Package reconcile closes the loop InsertJobExecutionStatus opens: it polls
+// every execution that hasn't reached a terminal status yet and, once the
+// backend reports one, persists the final status/exit code/captured logs so
+// GetScheduleExecutions and GetScheduleExecutionLogs have something to show.
+package reconcile
+
+import (
+	"log"
+	"time"
+
+	"proctor/internal/app/proctord/audit"
+	"proctor/internal/app/proctord/jobs/execution"
+	"proctor/internal/app/proctord/storage"
+	"proctor/internal/app/proctord/storage/postgres"
+	"proctor/internal/app/proctord/utility"
+)
+
+// DefaultPollInterval is how often the reconciler checks in-flight
+// executions for a terminal status.
+const DefaultPollInterval = 15 * time.Second
+
+// exitCodeSuccess/exitCodeFailure mirror the only two outcomes the backends report;
+// neither Executioner nor kubeClient exposes a real process exit code.
+const (
+	exitCodeSuccess = 0
+	exitCodeFailure = 1
+)
+
+// Reconciler is modeled on trigger.Trigger: a background poller started from
+// its constructor, the same convention acquire.NewAcquirer established.
+type Reconciler struct {
+	store       storage.Store
+	executioner execution.Executioner
+	auditor     audit.Auditor
+	interval    time.Duration
+}
+
+// New wires a Reconciler to store/executioner/auditor and starts its
+// background polling loop for the lifetime of the process.
+func New(store storage.Store, executioner execution.Executioner, auditor audit.Auditor) *Reconciler {
+	r := &Reconciler{
+		store:       store,
+		executioner: executioner,
+		auditor:     auditor,
+		interval:    DefaultPollInterval,
+	}
+
+	go r.run()
+
+	return r
+}
+
+func (r *Reconciler) run() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		r.tick()
+	}
+}
+
+func (r *Reconciler) tick() {
+	executions, err := r.store.GetInFlightExecutions()
+	if err != nil {
+		log.Printf("reconcile: failed to list in-flight executions: %v", err)
+		return
+	}
+
+	for _, execution := range executions {
+		go r.reconcileOne(execution)
+	}
+}
+
+// reconcileOne checks one in-flight execution's live status and, if it has
+// reached a terminal state, persists it along with whatever logs the
+// backend has for it. Non-terminal statuses are left alone - they'll be
+// polled again next tick.
+func (r *Reconciler) reconcileOne(inFlight postgres.JobsExecutionAudit) {
+	status, err := r.executioner.Status(inFlight.ExecutionName)
+	if err != nil {
+		status = utility.JobExecutionStatusFetchError
+	}
+
+	exitCode, ok := terminalExitCode(status)
+	if !ok {
+		return
+	}
+
+	capturedLogs, _ := r.executioner.Logs(inFlight.ExecutionName)
+	if err := r.auditor.AuditJobExecutionStatus(inFlight.JobID, status, exitCode, capturedLogs); err != nil {
+		log.Printf("reconcile: failed to persist terminal status for execution %d: %v", inFlight.JobID, err)
+	}
+}
+
+// terminalExitCode reports whether status is one the backend will never
+// advance past, and the exit code to record for it.
+func terminalExitCode(status string) (int, bool) {
+	switch status {
+	case utility.JobSucceeded:
+		return exitCodeSuccess, true
+	case utility.JobFailed, utility.JobExecutionStatusFetchError:
+		return exitCodeFailure, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,31 @@
+package reconcile
+
+import (
+	"testing"
+
+	"proctor/internal/app/proctord/utility"
+)
+
+func TestTerminalExitCode(t *testing.T) {
+	cases := []struct {
+		status       string
+		wantExitCode int
+		wantTerminal bool
+	}{
+		{utility.JobSucceeded, exitCodeSuccess, true},
+		{utility.JobFailed, exitCodeFailure, true},
+		{utility.JobExecutionStatusFetchError, exitCodeFailure, true},
+		{utility.JobWaiting, 0, false},
+		{"SOME_UNRECOGNIZED_RAW_K8S_STATUS", 0, false},
+	}
+
+	for _, c := range cases {
+		exitCode, ok := terminalExitCode(c.status)
+		if ok != c.wantTerminal {
+			t.Fatalf("terminalExitCode(%q) terminal = %v, want %v", c.status, ok, c.wantTerminal)
+		}
+		if ok && exitCode != c.wantExitCode {
+			t.Fatalf("terminalExitCode(%q) = %v, want %v", c.status, exitCode, c.wantExitCode)
+		}
+	}
+}
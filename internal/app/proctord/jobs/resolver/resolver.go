@@ -0,0 +1,126 @@
+package resolver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"proctor/internal/app/proctord/storage"
+	"proctor/internal/app/proctord/utility"
+	"proctor/pkg/job"
+
+	"github.com/gorilla/mux"
+)
+
+// Handler exposes the single GET /jobs/{guid} endpoint that unifies polling
+// across execution, schedule and bulk-apply operations.
+type Handler interface {
+	Get() http.HandlerFunc
+}
+
+type handler struct {
+	store storage.Store
+}
+
+func NewHandler(store storage.Store) Handler {
+	return &handler{store: store}
+}
+
+func (h *handler) Get() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		guid := mux.Vars(req)["guid"]
+
+		typ, resourceID, err := job.ParseGUID(guid)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		var result job.Job
+		switch typ {
+		case job.TypeExecute:
+			result, err = h.resolveExecute(guid, resourceID)
+		case job.TypeScheduleApply, job.TypeScheduleDelete:
+			result, err = h.resolveSchedule(guid, typ, resourceID)
+		case job.TypeBulkApply:
+			result, err = h.resolveBulkApply(guid, resourceID)
+		}
+
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(utility.NonExistentProcClientError))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(result)
+	}
+}
+
+func (h *handler) resolveExecute(guid, resourceID string) (job.Job, error) {
+	executionID, err := strconv.ParseInt(resourceID, 10, 64)
+	if err != nil {
+		return job.Job{}, err
+	}
+
+	execution, err := h.store.GetExecution(executionID)
+	if err != nil {
+		return job.Job{}, err
+	}
+
+	return job.Job{
+		GUID:         guid,
+		Type:         job.TypeExecute,
+		ResourceGUID: resourceID,
+		State:        executeState(execution.Status),
+		CreatedAt:    execution.StartedAt,
+		UpdatedAt:    execution.EndedAt,
+	}, nil
+}
+
+func (h *handler) resolveSchedule(guid string, typ job.Type, resourceID string) (job.Job, error) {
+	scheduledJob, err := h.store.GetScheduledJob(resourceID)
+	if err != nil {
+		return job.Job{}, err
+	}
+
+	return job.Job{
+		GUID:         guid,
+		Type:         typ,
+		ResourceGUID: resourceID,
+		State:        job.StateSucceeded,
+		CreatedAt:    scheduledJob.CreatedAt,
+		UpdatedAt:    scheduledJob.CreatedAt,
+	}, nil
+}
+
+func (h *handler) resolveBulkApply(guid, resourceID string) (job.Job, error) {
+	bulkApply, err := h.store.GetBulkApply(guid)
+	if err != nil {
+		return job.Job{}, err
+	}
+
+	return job.Job{
+		GUID:         guid,
+		Type:         job.TypeBulkApply,
+		ResourceGUID: resourceID,
+		State:        job.State(bulkApply.State),
+		Errors:       bulkApply.Errors,
+		Warnings:     bulkApply.Warnings,
+		CreatedAt:    bulkApply.CreatedAt,
+		UpdatedAt:    bulkApply.UpdatedAt,
+	}, nil
+}
+
+func executeState(status string) job.State {
+	switch status {
+	case utility.JobSucceeded:
+		return job.StateSucceeded
+	case utility.JobFailed:
+		return job.StateFailed
+	case utility.JobWaiting:
+		return job.StateRunning
+	default:
+		return job.StatePending
+	}
+}
@@ -0,0 +1,89 @@
+package schedule
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"proctor/internal/app/proctord/storage"
+	"proctor/internal/app/proctord/storage/postgres"
+	"proctor/internal/app/proctord/utility"
+	"proctor/internal/app/service/metadata"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduleHistoryTestSuite struct {
+	suite.Suite
+	mockStore         *storage.MockStore
+	mockMetadataStore *metadata.MockStore
+	scheduler         Scheduler
+}
+
+func (suite *ScheduleHistoryTestSuite) SetupTest() {
+	suite.mockStore = &storage.MockStore{}
+	suite.mockMetadataStore = &metadata.MockStore{}
+	suite.scheduler = NewScheduler(suite.mockStore, suite.mockMetadataStore)
+}
+
+func (suite *ScheduleHistoryTestSuite) TestGetScheduleExecutions() {
+	t := suite.T()
+
+	executions := []postgres.JobsExecutionAudit{
+		{JobID: 1, Status: utility.JobSucceeded, StartedAt: time.Now(), EndedAt: time.Now()},
+	}
+	suite.mockStore.On("GetScheduleExecutions", "schedule-1", defaultExecutionsPageSize, 0).Return(executions, nil)
+
+	req := httptest.NewRequest("GET", "/jobs/schedule/schedule-1/executions", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "schedule-1"})
+	responseRecorder := httptest.NewRecorder()
+
+	suite.scheduler.GetScheduleExecutions()(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var got []ScheduleExecution
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&got))
+	assert.Equal(t, int64(1), got[0].ExecutionID)
+	suite.mockStore.AssertExpectations(t)
+}
+
+func (suite *ScheduleHistoryTestSuite) TestGetScheduleExecutionLogsForeignExecutionIsNotFound() {
+	t := suite.T()
+
+	suite.mockStore.On("GetExecution", int64(42)).Return(postgres.JobsExecutionAudit{JobID: 42, ParentScheduleID: "schedule-2"}, nil)
+
+	req := httptest.NewRequest("GET", "/jobs/schedule/schedule-1/executions/42/logs", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "schedule-1", "execution_id": "42"})
+	responseRecorder := httptest.NewRecorder()
+
+	suite.scheduler.GetScheduleExecutionLogs()(responseRecorder, req)
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+	responseBody, _ := ioutil.ReadAll(responseRecorder.Body)
+	assert.Equal(t, utility.NonExistentProcClientError, string(responseBody))
+}
+
+func (suite *ScheduleHistoryTestSuite) TestGetScheduleExecutionLogsSuccess() {
+	t := suite.T()
+
+	suite.mockStore.On("GetExecution", int64(42)).Return(postgres.JobsExecutionAudit{JobID: 42, ParentScheduleID: "schedule-1", CapturedLogs: "hello world"}, nil)
+
+	req := httptest.NewRequest("GET", "/jobs/schedule/schedule-1/executions/42/logs", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "schedule-1", "execution_id": "42"})
+	responseRecorder := httptest.NewRecorder()
+
+	suite.scheduler.GetScheduleExecutionLogs()(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	responseBody, _ := ioutil.ReadAll(responseRecorder.Body)
+	assert.Equal(t, "hello world", string(responseBody))
+}
+
+func TestScheduleHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleHistoryTestSuite))
+}
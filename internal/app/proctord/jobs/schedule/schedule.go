@@ -0,0 +1,321 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"proctor/internal/app/proctord/jobs/logs"
+	"proctor/internal/app/proctord/storage"
+	"proctor/internal/app/proctord/utility"
+	"proctor/internal/app/service/metadata"
+	"proctor/pkg/job"
+
+	"github.com/gorilla/mux"
+	"github.com/robfig/cron"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultExecutionsPageSize = 20
+
+var emailRegexp = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
+
+// ScheduledJob is the wire representation of a cron-scheduled proc, both
+// when a client submits one and when the API lists existing schedules.
+type ScheduledJob struct {
+	ID                 string            `json:"id" yaml:"id"`
+	Name               string            `json:"name" yaml:"name"`
+	Args               map[string]string `json:"args" yaml:"args"`
+	Time               string            `json:"time" yaml:"time"`
+	NotificationEmails string            `json:"notification_emails" yaml:"notification_emails"`
+	Tags               string            `json:"tags" yaml:"tags"`
+	GUID               string            `json:"guid,omitempty" yaml:"-"`
+}
+
+// ScheduleExecution is the wire representation of a single past run that a
+// schedule triggered, returned by GetScheduleExecutions.
+type ScheduleExecution struct {
+	ExecutionID int64  `json:"execution_id"`
+	Status      string `json:"status"`
+	StartedAt   string `json:"started_at"`
+	FinishedAt  string `json:"finished_at"`
+	ExitCode    int    `json:"exit_code"`
+}
+
+// Scheduler exposes the HTTP handlers backing /jobs/schedule.
+type Scheduler interface {
+	Schedule() http.HandlerFunc
+	GetScheduledJobs() http.HandlerFunc
+	GetScheduledJob() http.HandlerFunc
+	RemoveScheduledJob() http.HandlerFunc
+	GetScheduleExecutions() http.HandlerFunc
+	GetScheduleExecutionLogs() http.HandlerFunc
+	BulkSchedule() http.HandlerFunc
+}
+
+type scheduler struct {
+	store         storage.Store
+	metadataStore metadata.Store
+}
+
+func NewScheduler(store storage.Store, metadataStore metadata.Store) Scheduler {
+	return &scheduler{store: store, metadataStore: metadataStore}
+}
+
+// validateScheduledJob applies the checks every schedule submission must
+// pass, whether it arrives alone via Schedule or as one entry of a
+// POST /jobs/schedule/bulk manifest. It returns the HTTP status and client
+// error body to write, or (0, "") when the job is valid.
+func (s *scheduler) validateScheduledJob(scheduledJob ScheduledJob) (int, string) {
+	if _, err := cron.ParseStandard(scheduledJob.Time); err != nil {
+		return http.StatusBadRequest, utility.InvalidCronExpressionClientError
+	}
+
+	for _, email := range strings.Split(scheduledJob.NotificationEmails, ",") {
+		if !emailRegexp.MatchString(email) {
+			return http.StatusBadRequest, utility.InvalidEmailIdClientError
+		}
+	}
+
+	if strings.TrimSpace(scheduledJob.Tags) == "" {
+		return http.StatusBadRequest, utility.InvalidTagError
+	}
+
+	if _, err := s.metadataStore.GetJobMetadata(scheduledJob.Name); err != nil {
+		return http.StatusNotFound, utility.NonExistentProcClientError
+	}
+
+	return 0, ""
+}
+
+func (s *scheduler) Schedule() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var scheduledJob ScheduledJob
+		if err := json.NewDecoder(req.Body).Decode(&scheduledJob); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		if status, message := s.validateScheduledJob(scheduledJob); status != 0 {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(message))
+			return
+		}
+
+		userEmail := req.Header.Get(utility.UserEmailHeaderKey)
+		id, err := s.store.InsertScheduledJob(scheduledJob.Name, scheduledJob.Tags, scheduledJob.Time, scheduledJob.NotificationEmails, userEmail, scheduledJob.Args)
+		if err != nil {
+			if strings.Contains(err.Error(), "unique_jobs_schedule_name_args") {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(utility.DuplicateJobNameArgsClientError))
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		scheduledJob.ID = id
+		scheduledJob.GUID = fmt.Sprintf("%s.%s", job.TypeScheduleApply, id)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(scheduledJob)
+	}
+}
+
+func (s *scheduler) GetScheduledJobs() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		jobs, err := s.store.GetEnabledScheduledJobs()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		scheduledJobs := make([]ScheduledJob, 0, len(jobs))
+		for _, job := range jobs {
+			scheduledJobs = append(scheduledJobs, ScheduledJob{
+				ID:                 job.ID,
+				Name:               job.Name,
+				Args:               job.Args,
+				Time:               job.Time,
+				NotificationEmails: job.NotificationEmails,
+				Tags:               job.Tags,
+			})
+		}
+
+		_ = json.NewEncoder(w).Encode(scheduledJobs)
+	}
+}
+
+func (s *scheduler) GetScheduledJob() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		id := mux.Vars(req)["id"]
+
+		job, err := s.store.GetScheduledJob(id)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(utility.NonExistentProcClientError))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(ScheduledJob{
+			ID:                 job.ID,
+			Name:               job.Name,
+			Args:               job.Args,
+			Time:               job.Time,
+			NotificationEmails: job.NotificationEmails,
+			Tags:               job.Tags,
+		})
+	}
+}
+
+func (s *scheduler) RemoveScheduledJob() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		id := mux.Vars(req)["id"]
+
+		if err := s.store.RemoveScheduledJob(id); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		guid := fmt.Sprintf("%s.%s", job.TypeScheduleDelete, id)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]string{"guid": guid})
+	}
+}
+
+// GetScheduleExecutions answers "show me the last N runs of this schedule",
+// paginated newest first via the jobs_execution_audit rows linked to it by
+// parent_schedule_id.
+func (s *scheduler) GetScheduleExecutions() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		id := mux.Vars(req)["id"]
+
+		limit := defaultExecutionsPageSize
+		if raw := req.URL.Query().Get("limit"); raw != "" {
+			if parsed, err := strconv.Atoi(raw); err == nil && parsed > 0 {
+				limit = parsed
+			}
+		}
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+
+		executions, err := s.store.GetScheduleExecutions(id, limit, offset)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		response := make([]ScheduleExecution, 0, len(executions))
+		for _, execution := range executions {
+			response = append(response, ScheduleExecution{
+				ExecutionID: execution.JobID,
+				Status:      execution.Status,
+				StartedAt:   execution.StartedAt.Format(time.RFC3339),
+				FinishedAt:  execution.EndedAt.Format(time.RFC3339),
+				ExitCode:    execution.ExitCode,
+			})
+		}
+
+		_ = json.NewEncoder(w).Encode(response)
+	}
+}
+
+// GetScheduleExecutionLogs streams the stdout/stderr captured for one
+// already-finished execution of this schedule, reusing the logs package
+// rather than logs.Logger.Stream (which reads a live pod or a remote run's
+// in-memory response, not the logs stored with the execution row).
+func (s *scheduler) GetScheduleExecutionLogs() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		vars := mux.Vars(req)
+		scheduleID := vars["id"]
+
+		executionID, err := strconv.ParseInt(vars["execution_id"], 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		execution, err := s.store.GetExecution(executionID)
+		if err != nil || execution.ParentScheduleID != scheduleID {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(utility.NonExistentProcClientError))
+			return
+		}
+
+		*req = *req.WithContext(context.WithValue(req.Context(), utility.JobExecutionIDContextKey, executionID))
+		logs.WriteCaptured(w, execution.CapturedLogs)
+	}
+}
+
+// BulkSchedule accepts a YAML or JSON manifest of many ScheduledJobs,
+// validates all of them up front with the same rules Schedule applies to a
+// single one, and persists the valid manifest in a single transaction.
+// Clients poll the returned bulk_apply.<uuid> job GUID via GET /jobs/{guid}
+// instead of waiting on a synchronous response for a potentially large
+// manifest.
+func (s *scheduler) BulkSchedule() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		var scheduledJobs []ScheduledJob
+		if err := json.Unmarshal(body, &scheduledJobs); err != nil {
+			if err := yaml.Unmarshal(body, &scheduledJobs); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(utility.ClientError))
+				return
+			}
+		}
+
+		for _, scheduledJob := range scheduledJobs {
+			if status, message := s.validateScheduledJob(scheduledJob); status != 0 {
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(message))
+				return
+			}
+		}
+
+		entries := make([]storage.BulkScheduleEntry, 0, len(scheduledJobs))
+		for _, scheduledJob := range scheduledJobs {
+			entries = append(entries, storage.BulkScheduleEntry{
+				Name:               scheduledJob.Name,
+				Tags:               scheduledJob.Tags,
+				Time:               scheduledJob.Time,
+				NotificationEmails: scheduledJob.NotificationEmails,
+				Args:               scheduledJob.Args,
+			})
+		}
+
+		guid := job.NewGUID(job.TypeBulkApply)
+		userEmail := req.Header.Get(utility.UserEmailHeaderKey)
+		if err := s.store.CreateBulkApply(guid); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		go func() {
+			if err := s.store.InsertScheduledJobsBulk(guid, entries, userEmail); err != nil {
+				log.Printf("bulk schedule %s failed: %v", guid, err)
+			}
+		}()
+
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(job.Job{GUID: guid, Type: job.TypeBulkApply, State: job.StatePending})
+	}
+}
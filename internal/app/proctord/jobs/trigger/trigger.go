@@ -0,0 +1,117 @@
+package trigger
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"proctor/internal/app/proctord/audit"
+	"proctor/internal/app/proctord/jobs/execution"
+	"proctor/internal/app/proctord/storage"
+	"proctor/internal/app/proctord/storage/postgres"
+	"proctor/internal/app/proctord/utility"
+
+	"github.com/robfig/cron"
+)
+
+// DefaultPollInterval is how often the trigger loop checks enabled
+// schedules for one whose cron expression has come due since the last poll.
+const DefaultPollInterval = 30 * time.Second
+
+// Trigger fires every enabled schedule whose cron expression comes due,
+// linking the resulting execution back to its schedule via
+// InsertJobExecutionStatus's parentScheduleID, so GetScheduleExecutions has
+// runs to report and /jobs/schedule/{id}/executions/{execution_id}/logs has
+// somewhere to look them up.
+type Trigger struct {
+	store       storage.Store
+	executioner execution.Executioner
+	auditor     audit.Auditor
+	interval    time.Duration
+
+	lastRun map[string]time.Time
+}
+
+// New wires a Trigger to store/executioner/auditor and starts its background
+// polling loop for the lifetime of the process, the same way acquire.NewAcquirer
+// starts its own background goroutines.
+func New(store storage.Store, executioner execution.Executioner, auditor audit.Auditor) *Trigger {
+	t := &Trigger{
+		store:       store,
+		executioner: executioner,
+		auditor:     auditor,
+		interval:    DefaultPollInterval,
+		lastRun:     make(map[string]time.Time),
+	}
+
+	go t.run()
+
+	return t
+}
+
+func (t *Trigger) run() {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		t.tick(now)
+	}
+}
+
+// tick fires every enabled schedule that has a cron occurrence between its
+// last observed fire (or, for a schedule not fired yet, one poll interval
+// before now) and now.
+func (t *Trigger) tick(now time.Time) {
+	schedules, err := t.store.GetEnabledScheduledJobs()
+	if err != nil {
+		log.Printf("trigger: failed to list enabled schedules: %v", err)
+		return
+	}
+
+	for _, scheduledJob := range schedules {
+		schedule, err := cron.ParseStandard(scheduledJob.Time)
+		if err != nil {
+			log.Printf("trigger: schedule %s has invalid cron expression %q: %v", scheduledJob.ID, scheduledJob.Time, err)
+			continue
+		}
+
+		since, seen := t.lastRun[scheduledJob.ID]
+		if !seen {
+			since = now.Add(-t.interval)
+		}
+
+		if schedule.Next(since).After(now) {
+			continue
+		}
+
+		t.lastRun[scheduledJob.ID] = now
+		go t.fire(scheduledJob, now)
+	}
+}
+
+// fire publishes the due run to jobs_queue, the same way InsertScheduledJob
+// does at creation time, so Acquirer-polling executor daemons see every
+// recurring run and not just the schedule's initial registration - then
+// executes it in-process and audits the result against the schedule.
+func (t *Trigger) fire(scheduledJob postgres.JobsSchedule, triggerTime time.Time) {
+	if err := t.store.EnqueueJob(scheduledJob.ID, strings.Split(scheduledJob.Tags, ",")); err != nil {
+		log.Printf("trigger: failed to enqueue schedule %s: %v", scheduledJob.ID, err)
+	}
+
+	execCtx := execution.ExecutionContext{ScheduleID: scheduledJob.ID, TriggerTime: triggerTime}
+
+	jobExecutionName, err := t.executioner.Execute(scheduledJob.Name, scheduledJob.Args, execCtx)
+
+	status := utility.JobSubmissionSuccess
+	var capturedLogs string
+	if err != nil {
+		status = utility.JobSubmissionServerError
+		log.Printf("trigger: schedule %s failed to execute: %v", scheduledJob.ID, err)
+	} else {
+		capturedLogs, _ = t.executioner.Logs(jobExecutionName)
+	}
+
+	if _, err := t.auditor.AuditJobsExecution(scheduledJob.Name, jobExecutionName, status, scheduledJob.Args, utility.WorkerEmail, scheduledJob.ID, capturedLogs); err != nil {
+		log.Printf("trigger: failed to audit execution for schedule %s: %v", scheduledJob.ID, err)
+	}
+}
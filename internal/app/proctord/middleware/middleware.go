@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"proctor/internal/app/proctord/utility"
+)
+
+// ValidateClientVersion rejects requests from a proctor CLI older than the
+// version this proctord requires, keyed off the Client-Version header.
+func ValidateClientVersion(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		minimumClientVersion := os.Getenv("MINIMUM_CLIENT_VERSION")
+		if minimumClientVersion == "" {
+			handler(w, req)
+			return
+		}
+
+		clientVersion := req.Header.Get(utility.ClientVersionHeaderKey)
+		if clientVersion != "" && clientVersion < minimumClientVersion {
+			w.WriteHeader(http.StatusUpgradeRequired)
+			_, _ = w.Write([]byte(fmt.Sprintf(utility.ClientOutdatedErrorMessage, clientVersion)))
+			return
+		}
+
+		handler(w, req)
+	}
+}
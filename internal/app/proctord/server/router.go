@@ -2,14 +2,21 @@ package server
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"path"
+	"time"
+
 	"proctor/internal/app/proctord/audit"
 	"proctor/internal/app/proctord/docs"
 	"proctor/internal/app/proctord/instrumentation"
+	"proctor/internal/app/proctord/jobs/acquire"
 	"proctor/internal/app/proctord/jobs/execution"
 	"proctor/internal/app/proctord/jobs/logs"
+	"proctor/internal/app/proctord/jobs/reconcile"
+	"proctor/internal/app/proctord/jobs/resolver"
 	"proctor/internal/app/proctord/jobs/schedule"
+	"proctor/internal/app/proctord/jobs/trigger"
 	"proctor/internal/app/proctord/middleware"
 	"proctor/internal/app/proctord/storage"
 	"proctor/internal/app/service/infra/config"
@@ -23,6 +30,7 @@ import (
 	secretRepository "proctor/internal/app/service/secret/repository"
 
 	"github.com/gorilla/mux"
+	"github.com/lib/pq"
 )
 
 var postgresClient postgresql.Client
@@ -43,14 +51,28 @@ func NewRouter() (*mux.Router, error) {
 	}
 	kubeClient := kubernetes.NewKubernetesClient(httpClient)
 
-	auditor := audit.New(store, kubeClient)
+	auditor := audit.New(store)
 	jobExecutioner := execution.NewExecutioner(kubeClient, metadataStore, secretsStore)
 	jobExecutionHandler := execution.NewExecutionHandler(auditor, store, jobExecutioner)
-	jobLogger := logs.NewLogger(kubeClient)
+	jobLogger := logs.NewLogger(kubeClient, jobExecutioner)
 	jobMetadataHandler := metadataHandler.NewMetadataHttpHandler(metadataStore)
 	jobSecretsHandler := secretHttpHandler.NewSecretHttpHandler(secretsStore)
 
 	scheduledJobsHandler := schedule.NewScheduler(store, metadataStore)
+	jobResolver := resolver.NewHandler(store)
+
+	jobsQueueListener := pq.NewListener(postgresql.ConnectionString(), 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("jobs_queue listener event=%d error=%v", event, err)
+		}
+	})
+	if err := jobsQueueListener.Listen(storage.JobsQueueNotifyChannel); err != nil {
+		return router, err
+	}
+	jobsAcquirer := acquire.NewAcquirer(store, jobsQueueListener)
+
+	trigger.New(store, jobExecutioner, auditor)
+	reconcile.New(store, jobExecutioner, auditor)
 
 	router.HandleFunc("/ping", func(w http.ResponseWriter, req *http.Request) {
 		_, _ = fmt.Fprintf(w, "pong")
@@ -64,6 +86,9 @@ func NewRouter() (*mux.Router, error) {
 
 	router.HandleFunc(instrumentation.Wrap("/jobs/execute", middleware.ValidateClientVersion(jobExecutionHandler.Handle()))).Methods("POST")
 	router.HandleFunc(instrumentation.Wrap("/jobs/execute/{name}/status", middleware.ValidateClientVersion(jobExecutionHandler.Status()))).Methods("GET")
+	router.HandleFunc(instrumentation.Wrap("/jobs/execute/replicas", middleware.ValidateClientVersion(jobExecutionHandler.Replicas()))).Methods("POST")
+	router.HandleFunc(instrumentation.Wrap("/jobs/execute/replicas/{group_id}", middleware.ValidateClientVersion(jobExecutionHandler.ReplicasStatus()))).Methods("GET")
+	router.HandleFunc(instrumentation.Wrap("/jobs/execute/replicas/{group_id}", middleware.ValidateClientVersion(jobExecutionHandler.CancelReplicas()))).Methods("DELETE")
 	router.HandleFunc(instrumentation.Wrap("/jobs/logs", middleware.ValidateClientVersion(jobLogger.Stream()))).Methods("GET")
 	router.HandleFunc(instrumentation.Wrap("/jobs/metadata", middleware.ValidateClientVersion(jobMetadataHandler.Post()))).Methods("POST")
 	router.HandleFunc(instrumentation.Wrap("/jobs/metadata", middleware.ValidateClientVersion(jobMetadataHandler.GetAll()))).Methods("GET")
@@ -72,6 +97,15 @@ func NewRouter() (*mux.Router, error) {
 	router.HandleFunc(instrumentation.Wrap("/jobs/schedule", middleware.ValidateClientVersion(scheduledJobsHandler.GetScheduledJobs()))).Methods("GET")
 	router.HandleFunc(instrumentation.Wrap("/jobs/schedule/{id}", middleware.ValidateClientVersion(scheduledJobsHandler.GetScheduledJob()))).Methods("GET")
 	router.HandleFunc(instrumentation.Wrap("/jobs/schedule/{id}", middleware.ValidateClientVersion(scheduledJobsHandler.RemoveScheduledJob()))).Methods("DELETE")
+	router.HandleFunc(instrumentation.Wrap("/jobs/schedule/{id}/executions", middleware.ValidateClientVersion(scheduledJobsHandler.GetScheduleExecutions()))).Methods("GET")
+	router.HandleFunc(instrumentation.Wrap("/jobs/schedule/{id}/executions/{execution_id}/logs", middleware.ValidateClientVersion(scheduledJobsHandler.GetScheduleExecutionLogs()))).Methods("GET")
+	router.HandleFunc(instrumentation.Wrap("/jobs/schedule/bulk", middleware.ValidateClientVersion(scheduledJobsHandler.BulkSchedule()))).Methods("POST")
+	router.HandleFunc(instrumentation.Wrap("/jobs/acquire", middleware.ValidateClientVersion(jobsAcquirer.Acquire()))).Methods("POST")
+	router.HandleFunc(instrumentation.Wrap("/jobs/acquire/{id}/heartbeat", middleware.ValidateClientVersion(jobsAcquirer.Heartbeat()))).Methods("POST")
+
+	// Must be registered last: {guid} is a single-segment catch-all and would
+	// otherwise shadow the more specific /jobs/... routes above it.
+	router.HandleFunc(instrumentation.Wrap("/jobs/{guid}", middleware.ValidateClientVersion(jobResolver.Get()))).Methods("GET")
 
 	return router, nil
 }
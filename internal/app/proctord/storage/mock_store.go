@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"time"
+
+	"proctor/internal/app/proctord/storage/postgres"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockStore is a testify mock of Store, for handler tests that shouldn't
+// need a real Postgres connection.
+type MockStore struct {
+	mock.Mock
+}
+
+func (m *MockStore) InsertScheduledJob(name, tags, cronTime, notificationEmails, userEmail string, args map[string]string) (string, error) {
+	arguments := m.Called(name, tags, cronTime, notificationEmails, userEmail, args)
+	return arguments.String(0), arguments.Error(1)
+}
+
+func (m *MockStore) GetEnabledScheduledJobs() ([]postgres.JobsSchedule, error) {
+	arguments := m.Called()
+	return arguments.Get(0).([]postgres.JobsSchedule), arguments.Error(1)
+}
+
+func (m *MockStore) GetScheduledJob(id string) (postgres.JobsSchedule, error) {
+	arguments := m.Called(id)
+	return arguments.Get(0).(postgres.JobsSchedule), arguments.Error(1)
+}
+
+func (m *MockStore) RemoveScheduledJob(id string) error {
+	arguments := m.Called(id)
+	return arguments.Error(0)
+}
+
+func (m *MockStore) InsertJobExecutionStatus(jobName, executionName, jobSubmissionStatus string, jobArgs map[string]string, userEmail, parentScheduleID, capturedLogs string) (int64, error) {
+	arguments := m.Called(jobName, executionName, jobSubmissionStatus, jobArgs, userEmail, parentScheduleID, capturedLogs)
+	return arguments.Get(0).(int64), arguments.Error(1)
+}
+
+func (m *MockStore) CompleteJobExecution(jobExecutionID int64, status string, exitCode int, capturedLogs string) error {
+	arguments := m.Called(jobExecutionID, status, exitCode, capturedLogs)
+	return arguments.Error(0)
+}
+
+func (m *MockStore) GetJobExecutionStatus(jobExecutionID int64) (string, error) {
+	arguments := m.Called(jobExecutionID)
+	return arguments.String(0), arguments.Error(1)
+}
+
+func (m *MockStore) GetScheduleExecutions(scheduleID string, limit, offset int) ([]postgres.JobsExecutionAudit, error) {
+	arguments := m.Called(scheduleID, limit, offset)
+	return arguments.Get(0).([]postgres.JobsExecutionAudit), arguments.Error(1)
+}
+
+func (m *MockStore) GetExecution(jobExecutionID int64) (postgres.JobsExecutionAudit, error) {
+	arguments := m.Called(jobExecutionID)
+	return arguments.Get(0).(postgres.JobsExecutionAudit), arguments.Error(1)
+}
+
+func (m *MockStore) GetInFlightExecutions() ([]postgres.JobsExecutionAudit, error) {
+	arguments := m.Called()
+	return arguments.Get(0).([]postgres.JobsExecutionAudit), arguments.Error(1)
+}
+
+func (m *MockStore) EnqueueJob(jobID string, tags []string) error {
+	arguments := m.Called(jobID, tags)
+	return arguments.Error(0)
+}
+
+func (m *MockStore) ClaimJobForTags(tags []string, workerID string) (*postgres.JobsQueue, error) {
+	arguments := m.Called(tags, workerID)
+	queued, _ := arguments.Get(0).(*postgres.JobsQueue)
+	return queued, arguments.Error(1)
+}
+
+func (m *MockStore) HeartbeatClaimedJob(jobID, workerID string) error {
+	arguments := m.Called(jobID, workerID)
+	return arguments.Error(0)
+}
+
+func (m *MockStore) RequeueStaleClaims(lease time.Duration) ([]string, error) {
+	arguments := m.Called(lease)
+	jobIDs, _ := arguments.Get(0).([]string)
+	return jobIDs, arguments.Error(1)
+}
+
+func (m *MockStore) CreateBulkApply(guid string) error {
+	arguments := m.Called(guid)
+	return arguments.Error(0)
+}
+
+func (m *MockStore) InsertScheduledJobsBulk(guid string, entries []BulkScheduleEntry, userEmail string) error {
+	arguments := m.Called(guid, entries, userEmail)
+	return arguments.Error(0)
+}
+
+func (m *MockStore) GetBulkApply(guid string) (postgres.JobsBulkApply, error) {
+	arguments := m.Called(guid)
+	return arguments.Get(0).(postgres.JobsBulkApply), arguments.Error(1)
+}
+
+func (m *MockStore) InsertJobsGroup(groupID, jobName string, podNames []string) error {
+	arguments := m.Called(groupID, jobName, podNames)
+	return arguments.Error(0)
+}
+
+func (m *MockStore) GetJobsGroup(groupID string) ([]postgres.JobsGroup, error) {
+	arguments := m.Called(groupID)
+	group, _ := arguments.Get(0).([]postgres.JobsGroup)
+	return group, arguments.Error(1)
+}
+
+func (m *MockStore) RemoveJobsGroup(groupID string) error {
+	arguments := m.Called(groupID)
+	return arguments.Error(0)
+}
@@ -0,0 +1,14 @@
+package postgres
+
+import "time"
+
+// JobsBulkApply is the row shape of the jobs_bulk_apply table: one row per
+// POST /jobs/schedule/bulk submission, polled by its bulk_apply.<uuid> GUID.
+type JobsBulkApply struct {
+	GUID      string
+	State     string
+	Errors    []string
+	Warnings  []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
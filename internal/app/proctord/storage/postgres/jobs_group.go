@@ -0,0 +1,12 @@
+package postgres
+
+import "time"
+
+// JobsGroup is the row shape of the jobs_group table: one row per replica
+// provisioned by POST /jobs/execute/replicas, sharing a GroupID.
+type JobsGroup struct {
+	GroupID   string
+	JobName   string
+	PodName   string
+	CreatedAt time.Time
+}
@@ -0,0 +1,15 @@
+package postgres
+
+import "time"
+
+// JobsQueue is the row shape of the jobs_queue table: one row per job that
+// is waiting to be (or has been) claimed by an executor daemon via the
+// acquire subsystem.
+type JobsQueue struct {
+	ID        int64
+	JobID     string
+	Tags      []string
+	ClaimedBy string
+	ClaimedAt time.Time
+	Heartbeat time.Time
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// JSONMap stores a map[string]string as a jsonb column. database/sql has no
+// built-in conversion for a bare Go map on either side of a query, so every
+// column typed as JSONMap needs this explicit marshal/unmarshal.
+type JSONMap map[string]string
+
+// Value marshals m to JSON for the query parameter placeholder.
+func (m JSONMap) Value() (driver.Value, error) {
+	if m == nil {
+		return "{}", nil
+	}
+	return json.Marshal(map[string]string(m))
+}
+
+// Scan unmarshals a jsonb column back into m.
+func (m *JSONMap) Scan(src interface{}) error {
+	if src == nil {
+		*m = JSONMap{}
+		return nil
+	}
+
+	var raw []byte
+	switch v := src.(type) {
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("postgres: unsupported source type %T for JSONMap", src)
+	}
+
+	return json.Unmarshal(raw, (*map[string]string)(m))
+}
+
+// JobsSchedule is the row shape of the jobs_schedule table.
+type JobsSchedule struct {
+	ID                 string
+	Name               string
+	Tags               string
+	Time               string
+	NotificationEmails string
+	UserEmail          string
+	Args               JSONMap
+	Enabled            bool
+	CreatedAt          time.Time
+}
+
+// JobsExecutionAudit is the row shape of the jobs_execution_audit table.
+type JobsExecutionAudit struct {
+	JobID            int64
+	JobName          string
+	ExecutionName    string
+	JobArgs          JSONMap
+	UserEmail        string
+	Status           string
+	StartedAt        time.Time
+	EndedAt          time.Time
+	ParentScheduleID string
+	ExitCode         int
+	CapturedLogs     string
+}
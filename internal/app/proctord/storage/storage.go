@@ -0,0 +1,443 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"proctor/internal/app/proctord/storage/postgres"
+	"proctor/pkg/job"
+)
+
+// JobsQueueNotifyChannel is the Postgres channel the acquire subsystem
+// LISTENs on; every job made available to be claimed is announced here so
+// waiting executor daemons wake up instead of polling.
+const JobsQueueNotifyChannel = "jobs_queue_available"
+
+type jobsQueueNotification struct {
+	JobID string   `json:"job_id"`
+	Tags  []string `json:"tags"`
+}
+
+// Store is the persistence surface shared by the scheduler and the execution
+// auditor.
+type Store interface {
+	InsertScheduledJob(name, tags, time, notificationEmails, userEmail string, args map[string]string) (string, error)
+	GetEnabledScheduledJobs() ([]postgres.JobsSchedule, error)
+	GetScheduledJob(id string) (postgres.JobsSchedule, error)
+	RemoveScheduledJob(id string) error
+
+	InsertJobExecutionStatus(jobName, executionName, jobSubmissionStatus string, jobArgs map[string]string, userEmail, parentScheduleID, capturedLogs string) (int64, error)
+	CompleteJobExecution(jobExecutionID int64, status string, exitCode int, capturedLogs string) error
+	GetJobExecutionStatus(jobExecutionID int64) (string, error)
+	GetScheduleExecutions(scheduleID string, limit, offset int) ([]postgres.JobsExecutionAudit, error)
+	GetExecution(jobExecutionID int64) (postgres.JobsExecutionAudit, error)
+	GetInFlightExecutions() ([]postgres.JobsExecutionAudit, error)
+
+	EnqueueJob(jobID string, tags []string) error
+	ClaimJobForTags(tags []string, workerID string) (*postgres.JobsQueue, error)
+	HeartbeatClaimedJob(jobID, workerID string) error
+	RequeueStaleClaims(lease time.Duration) ([]string, error)
+
+	CreateBulkApply(guid string) error
+	InsertScheduledJobsBulk(guid string, entries []BulkScheduleEntry, userEmail string) error
+	GetBulkApply(guid string) (postgres.JobsBulkApply, error)
+
+	InsertJobsGroup(groupID, jobName string, podNames []string) error
+	GetJobsGroup(groupID string) ([]postgres.JobsGroup, error)
+	RemoveJobsGroup(groupID string) error
+}
+
+// BulkScheduleEntry is one schedule within a POST /jobs/schedule/bulk
+// manifest, already validated by the time it reaches the store.
+type BulkScheduleEntry struct {
+	Name               string
+	Tags               string
+	Time               string
+	NotificationEmails string
+	Args               map[string]string
+}
+
+type store struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) Store {
+	return &store{db: db}
+}
+
+func (s *store) InsertScheduledJob(name, tags, cronTime, notificationEmails, userEmail string, args map[string]string) (string, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	var id string
+	row := tx.QueryRow(
+		`INSERT INTO jobs_schedule (name, tags, time, notification_emails, user_email, args, enabled)
+		 VALUES ($1, $2, $3, $4, $5, $6, true) RETURNING id`,
+		name, tags, cronTime, notificationEmails, userEmail, postgres.JSONMap(args),
+	)
+	if err := row.Scan(&id); err != nil {
+		return "", err
+	}
+
+	if err := s.enqueueJob(tx, id, strings.Split(tags, ",")); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (s *store) GetEnabledScheduledJobs() ([]postgres.JobsSchedule, error) {
+	rows, err := s.db.Query(`SELECT id, name, tags, time, notification_emails, user_email, args FROM jobs_schedule WHERE enabled = true`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scheduledJobs []postgres.JobsSchedule
+	for rows.Next() {
+		var job postgres.JobsSchedule
+		if err := rows.Scan(&job.ID, &job.Name, &job.Tags, &job.Time, &job.NotificationEmails, &job.UserEmail, &job.Args); err != nil {
+			return nil, err
+		}
+		scheduledJobs = append(scheduledJobs, job)
+	}
+	return scheduledJobs, rows.Err()
+}
+
+func (s *store) GetScheduledJob(id string) (postgres.JobsSchedule, error) {
+	var job postgres.JobsSchedule
+	row := s.db.QueryRow(`SELECT id, name, tags, time, notification_emails, user_email, args FROM jobs_schedule WHERE id = $1`, id)
+	err := row.Scan(&job.ID, &job.Name, &job.Tags, &job.Time, &job.NotificationEmails, &job.UserEmail, &job.Args)
+	return job, err
+}
+
+func (s *store) RemoveScheduledJob(id string) error {
+	_, err := s.db.Exec(`UPDATE jobs_schedule SET enabled = false WHERE id = $1`, id)
+	return err
+}
+
+func (s *store) InsertJobExecutionStatus(jobName, executionName, jobSubmissionStatus string, jobArgs map[string]string, userEmail, parentScheduleID, capturedLogs string) (int64, error) {
+	var jobExecutionID int64
+	row := s.db.QueryRow(
+		`INSERT INTO jobs_execution_audit (job_name, execution_name, job_args, user_email, status, started_at, parent_schedule_id, captured_logs)
+		 VALUES ($1, NULLIF($2, ''), $3, $4, $5, now(), NULLIF($6, ''), $7) RETURNING job_id`,
+		jobName, executionName, postgres.JSONMap(jobArgs), userEmail, jobSubmissionStatus, parentScheduleID, capturedLogs,
+	)
+	if err := row.Scan(&jobExecutionID); err != nil {
+		return 0, err
+	}
+	return jobExecutionID, nil
+}
+
+// CompleteJobExecution records the terminal outcome of a run that was
+// previously submitted via InsertJobExecutionStatus: the reconciler calls
+// this once the backend reports SUCCEEDED/FAILED, so ended_at/exit_code
+// leave NULL and status advances past the submission-time value.
+func (s *store) CompleteJobExecution(jobExecutionID int64, status string, exitCode int, capturedLogs string) error {
+	_, err := s.db.Exec(
+		`UPDATE jobs_execution_audit SET status = $1, exit_code = $2, captured_logs = $3, ended_at = now() WHERE job_id = $4`,
+		status, exitCode, capturedLogs, jobExecutionID,
+	)
+	return err
+}
+
+func (s *store) GetJobExecutionStatus(jobExecutionID int64) (string, error) {
+	var status string
+	row := s.db.QueryRow(`SELECT status FROM jobs_execution_audit WHERE job_id = $1`, jobExecutionID)
+	err := row.Scan(&status)
+	return status, err
+}
+
+// GetScheduleExecutions returns the most recent executions a scheduled job
+// triggered, newest first, for the /jobs/schedule/{id}/executions endpoint.
+// ended_at/exit_code are NULL until the reconciler observes a terminal
+// status, so they're scanned into their nullable driver types and only
+// applied to the non-nullable postgres.JobsExecutionAudit fields once known.
+func (s *store) GetScheduleExecutions(scheduleID string, limit, offset int) ([]postgres.JobsExecutionAudit, error) {
+	rows, err := s.db.Query(
+		`SELECT job_id, job_name, status, started_at, ended_at, exit_code FROM jobs_execution_audit
+		 WHERE parent_schedule_id = $1 ORDER BY started_at DESC LIMIT $2 OFFSET $3`,
+		scheduleID, limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var executions []postgres.JobsExecutionAudit
+	for rows.Next() {
+		var execution postgres.JobsExecutionAudit
+		var endedAt sql.NullTime
+		var exitCode sql.NullInt64
+		if err := rows.Scan(&execution.JobID, &execution.JobName, &execution.Status, &execution.StartedAt, &endedAt, &exitCode); err != nil {
+			return nil, err
+		}
+		execution.EndedAt = endedAt.Time
+		execution.ExitCode = int(exitCode.Int64)
+		execution.ParentScheduleID = scheduleID
+		executions = append(executions, execution)
+	}
+	return executions, rows.Err()
+}
+
+// GetExecution fetches one execution row, including its captured logs and
+// the schedule that triggered it (empty for a manual execution), for the
+// /jobs/schedule/{id}/executions/{execution_id}/logs endpoint.
+func (s *store) GetExecution(jobExecutionID int64) (postgres.JobsExecutionAudit, error) {
+	var execution postgres.JobsExecutionAudit
+	var parentScheduleID sql.NullString
+	var endedAt sql.NullTime
+	var exitCode sql.NullInt64
+	row := s.db.QueryRow(
+		`SELECT job_id, job_name, status, started_at, ended_at, exit_code, captured_logs, parent_schedule_id FROM jobs_execution_audit WHERE job_id = $1`,
+		jobExecutionID,
+	)
+	err := row.Scan(&execution.JobID, &execution.JobName, &execution.Status, &execution.StartedAt, &endedAt, &exitCode, &execution.CapturedLogs, &parentScheduleID)
+	execution.EndedAt = endedAt.Time
+	execution.ExitCode = int(exitCode.Int64)
+	execution.ParentScheduleID = parentScheduleID.String
+	return execution, err
+}
+
+// GetInFlightExecutions returns every execution the reconciler hasn't yet
+// observed a terminal status for, so it knows what to poll.
+func (s *store) GetInFlightExecutions() ([]postgres.JobsExecutionAudit, error) {
+	rows, err := s.db.Query(
+		`SELECT job_id, job_name, execution_name, status FROM jobs_execution_audit
+		 WHERE ended_at IS NULL AND execution_name IS NOT NULL`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var executions []postgres.JobsExecutionAudit
+	for rows.Next() {
+		var execution postgres.JobsExecutionAudit
+		var executionName sql.NullString
+		if err := rows.Scan(&execution.JobID, &execution.JobName, &executionName, &execution.Status); err != nil {
+			return nil, err
+		}
+		execution.ExecutionName = executionName.String
+		executions = append(executions, execution)
+	}
+	return executions, rows.Err()
+}
+
+// enqueueJob inserts jobID into jobs_queue and announces it over Postgres
+// NOTIFY, in the same transaction as the row that made it available, so the
+// acquire subsystem never observes a notification for a job it can't yet
+// claim (and never sees a queued job it was never notified about).
+func (s *store) enqueueJob(tx *sql.Tx, jobID string, tags []string) error {
+	if _, err := tx.Exec(`INSERT INTO jobs_queue (job_id, tags) VALUES ($1, $2)`, jobID, pq.Array(tags)); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(jobsQueueNotification{JobID: jobID, Tags: tags})
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`SELECT pg_notify($1, $2)`, JobsQueueNotifyChannel, string(payload))
+	return err
+}
+
+// EnqueueJob publishes jobID to jobs_queue on its own transaction, for
+// callers that aren't already inside one - the cron trigger firing a
+// recurring schedule, rather than the schedule's initial creation.
+func (s *store) EnqueueJob(jobID string, tags []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := s.enqueueJob(tx, jobID, tags); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ClaimJobForTags atomically claims one unclaimed row in jobs_queue whose
+// tags intersect tags, using SELECT ... FOR UPDATE SKIP LOCKED so concurrent
+// waiters never race for the same job. Returns sql.ErrNoRows when nothing
+// matches.
+func (s *store) ClaimJobForTags(tags []string, workerID string) (*postgres.JobsQueue, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var queued postgres.JobsQueue
+	row := tx.QueryRow(
+		`SELECT id, job_id, tags FROM jobs_queue
+		 WHERE claimed_by IS NULL AND tags && $1
+		 ORDER BY id FOR UPDATE SKIP LOCKED LIMIT 1`,
+		pq.Array(tags),
+	)
+	if err := row.Scan(&queued.ID, &queued.JobID, pq.Array(&queued.Tags)); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(`UPDATE jobs_queue SET claimed_by = $1, claimed_at = now(), heartbeat = now() WHERE id = $2`, workerID, queued.ID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	queued.ClaimedBy = workerID
+	return &queued, nil
+}
+
+func (s *store) HeartbeatClaimedJob(jobID, workerID string) error {
+	_, err := s.db.Exec(`UPDATE jobs_queue SET heartbeat = now() WHERE job_id = $1 AND claimed_by = $2`, jobID, workerID)
+	return err
+}
+
+// RequeueStaleClaims releases any claim whose lease has expired without a
+// heartbeat, so the job becomes claimable again, and returns the job ids it
+// requeued.
+func (s *store) RequeueStaleClaims(lease time.Duration) ([]string, error) {
+	rows, err := s.db.Query(
+		`UPDATE jobs_queue SET claimed_by = NULL, claimed_at = NULL
+		 WHERE claimed_by IS NOT NULL AND heartbeat < now() - $1::interval
+		 RETURNING job_id`,
+		lease.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobIDs []string
+	for rows.Next() {
+		var jobID string
+		if err := rows.Scan(&jobID); err != nil {
+			return nil, err
+		}
+		jobIDs = append(jobIDs, jobID)
+	}
+	return jobIDs, rows.Err()
+}
+
+// CreateBulkApply records guid as a pending bulk-apply job before the
+// manifest behind it has been processed, so BulkSchedule can hand the GUID
+// back to the client immediately and let InsertScheduledJobsBulk run the
+// actual work asynchronously.
+func (s *store) CreateBulkApply(guid string) error {
+	_, err := s.db.Exec(
+		`INSERT INTO jobs_bulk_apply (guid, state, created_at, updated_at) VALUES ($1, $2, now(), now())`,
+		guid, job.StatePending,
+	)
+	return err
+}
+
+// InsertScheduledJobsBulk persists every entry in one transaction, tagging
+// the resulting jobs_schedule rows with guid, and transitions the guid's
+// jobs_bulk_apply row (created beforehand by CreateBulkApply) to its
+// terminal state. The state update always runs on s.db rather than tx, since
+// a failure's UPDATE would otherwise be discarded by the same rollback that
+// the failing INSERT triggers.
+func (s *store) InsertScheduledJobsBulk(guid string, entries []BulkScheduleEntry, userEmail string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		_, _ = s.db.Exec(`UPDATE jobs_bulk_apply SET state = $1, errors = array_append(errors, $2), updated_at = now() WHERE guid = $3`, job.StateFailed, err.Error(), guid)
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, entry := range entries {
+		var id string
+		row := tx.QueryRow(
+			`INSERT INTO jobs_schedule (name, tags, time, notification_emails, user_email, args, enabled, bulk_apply_guid)
+			 VALUES ($1, $2, $3, $4, $5, $6, true, $7) RETURNING id`,
+			entry.Name, entry.Tags, entry.Time, entry.NotificationEmails, userEmail, postgres.JSONMap(entry.Args), guid,
+		)
+		if err := row.Scan(&id); err != nil {
+			_ = tx.Rollback()
+			_, _ = s.db.Exec(`UPDATE jobs_bulk_apply SET state = $1, errors = array_append(errors, $2), updated_at = now() WHERE guid = $3`, job.StateFailed, err.Error(), guid)
+			return err
+		}
+
+		if err := s.enqueueJob(tx, id, strings.Split(entry.Tags, ",")); err != nil {
+			_ = tx.Rollback()
+			_, _ = s.db.Exec(`UPDATE jobs_bulk_apply SET state = $1, errors = array_append(errors, $2), updated_at = now() WHERE guid = $3`, job.StateFailed, err.Error(), guid)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		_, _ = s.db.Exec(`UPDATE jobs_bulk_apply SET state = $1, errors = array_append(errors, $2), updated_at = now() WHERE guid = $3`, job.StateFailed, err.Error(), guid)
+		return err
+	}
+
+	_, err = s.db.Exec(`UPDATE jobs_bulk_apply SET state = $1, updated_at = now() WHERE guid = $2`, job.StateSucceeded, guid)
+	return err
+}
+
+func (s *store) GetBulkApply(guid string) (postgres.JobsBulkApply, error) {
+	var bulkApply postgres.JobsBulkApply
+	row := s.db.QueryRow(`SELECT guid, state, errors, warnings, created_at, updated_at FROM jobs_bulk_apply WHERE guid = $1`, guid)
+	err := row.Scan(&bulkApply.GUID, &bulkApply.State, pq.Array(&bulkApply.Errors), pq.Array(&bulkApply.Warnings), &bulkApply.CreatedAt, &bulkApply.UpdatedAt)
+	return bulkApply, err
+}
+
+// InsertJobsGroup records one row per replica POST /jobs/execute/replicas
+// provisioned, all sharing groupID, so GetJobsGroup can report on the whole
+// batch later.
+func (s *store) InsertJobsGroup(groupID, jobName string, podNames []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, podName := range podNames {
+		if _, err := tx.Exec(
+			`INSERT INTO jobs_group (group_id, job_name, pod_name, created_at) VALUES ($1, $2, $3, now())`,
+			groupID, jobName, podName,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *store) GetJobsGroup(groupID string) ([]postgres.JobsGroup, error) {
+	rows, err := s.db.Query(`SELECT group_id, job_name, pod_name, created_at FROM jobs_group WHERE group_id = $1`, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var group []postgres.JobsGroup
+	for rows.Next() {
+		var row postgres.JobsGroup
+		if err := rows.Scan(&row.GroupID, &row.JobName, &row.PodName, &row.CreatedAt); err != nil {
+			return nil, err
+		}
+		group = append(group, row)
+	}
+	return group, rows.Err()
+}
+
+func (s *store) RemoveJobsGroup(groupID string) error {
+	_, err := s.db.Exec(`DELETE FROM jobs_group WHERE group_id = $1`, groupID)
+	return err
+}
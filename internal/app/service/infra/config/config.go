@@ -0,0 +1,11 @@
+package config
+
+import "os"
+
+func DocsPath() string {
+	path := os.Getenv("DOCS_PATH")
+	if path == "" {
+		path = "./docs"
+	}
+	return path
+}
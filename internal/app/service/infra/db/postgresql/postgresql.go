@@ -0,0 +1,24 @@
+package postgresql
+
+import (
+	"database/sql"
+	"os"
+
+	_ "github.com/lib/pq"
+)
+
+type Client = *sql.DB
+
+func NewClient() Client {
+	db, err := sql.Open("postgres", ConnectionString())
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
+// ConnectionString returns the DSN used both for database/sql connections
+// and for pq.Listener, which needs the raw DSN rather than an *sql.DB.
+func ConnectionString() string {
+	return os.Getenv("POSTGRES_CONNECTION_STRING")
+}
@@ -0,0 +1,17 @@
+package redis
+
+import (
+	"os"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+type Client = *redis.Pool
+
+func NewClient() Client {
+	return &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", os.Getenv("REDIS_ADDRESS"))
+		},
+	}
+}
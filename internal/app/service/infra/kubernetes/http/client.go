@@ -0,0 +1,22 @@
+package http
+
+import (
+	"net/http"
+	"os"
+	"time"
+)
+
+// NewClient builds the http.Client used to talk to the Kubernetes API server:
+// a plain client with a 30s timeout. It loads no cluster CA or service-account
+// token, and KUBE_CONFIG does not change the client it returns.
+func NewClient() (*http.Client, error) {
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	if os.Getenv("KUBE_CONFIG") == "" {
+		return client, nil
+	}
+
+	return client, nil
+}
@@ -0,0 +1,53 @@
+package kubernetes
+
+import (
+	"fmt"
+	"net/http"
+
+	uuid "github.com/google/uuid"
+)
+
+// Client talks to the Kubernetes API server to run procs as Jobs and to
+// inspect/stream the pods backing them.
+type Client interface {
+	ExecuteJob(imageName string, envVars map[string]string, resourceRequest ResourceRequest) (string, error)
+	JobExecutionStatus(jobName string) (string, error)
+	StreamJobLogs(jobName string, writer http.ResponseWriter) error
+	CancelJob(jobName string) error
+}
+
+// ResourceRequest captures the cpu/memory/gpu a caller asked a Kubernetes Job
+// to be scheduled with.
+type ResourceRequest struct {
+	CPU    string
+	Memory string
+	GPU    string
+}
+
+// client makes no calls to the API server: ExecuteJob only mints a job name
+// from imageName (envVars and resourceRequest are not used),
+// JobExecutionStatus returns an empty status, and StreamJobLogs and CancelJob
+// do nothing.
+type client struct {
+	httpClient *http.Client
+}
+
+func NewKubernetesClient(httpClient *http.Client) Client {
+	return &client{httpClient: httpClient}
+}
+
+func (c *client) ExecuteJob(imageName string, envVars map[string]string, resourceRequest ResourceRequest) (string, error) {
+	return fmt.Sprintf("%s-%s", imageName, uuid.New().String()), nil
+}
+
+func (c *client) JobExecutionStatus(jobName string) (string, error) {
+	return "", nil
+}
+
+func (c *client) StreamJobLogs(jobName string, writer http.ResponseWriter) error {
+	return nil
+}
+
+func (c *client) CancelJob(jobName string) error {
+	return nil
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"proctor/internal/app/proctord/utility"
+	"proctor/internal/app/service/metadata"
+)
+
+type metadataHttpHandler struct {
+	store metadata.Store
+}
+
+func NewMetadataHttpHandler(store metadata.Store) *metadataHttpHandler {
+	return &metadataHttpHandler{store: store}
+}
+
+func (handler *metadataHttpHandler) Post() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var jobMetadata metadata.Metadata
+		if err := json.NewDecoder(req.Body).Decode(&jobMetadata); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		if jobMetadata.Kind == metadata.KindRemote {
+			if err := metadata.ValidateRemote(jobMetadata.Remote); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(err.Error()))
+				return
+			}
+		}
+
+		if err := handler.store.Save(jobMetadata.Name, &jobMetadata); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+func (handler *metadataHttpHandler) GetAll() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		all, err := handler.store.GetAll()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(all)
+	}
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"proctor/internal/app/proctord/utility"
+	"proctor/internal/app/service/metadata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type MetadataHandlerTestSuite struct {
+	suite.Suite
+	mockStore *metadata.MockStore
+	handler   *metadataHttpHandler
+}
+
+func (suite *MetadataHandlerTestSuite) SetupTest() {
+	suite.mockStore = &metadata.MockStore{}
+	suite.handler = NewMetadataHttpHandler(suite.mockStore)
+}
+
+func (suite *MetadataHandlerTestSuite) TestPostSavesValidContainerMetadata() {
+	t := suite.T()
+
+	jobMetadata := metadata.Metadata{Name: "any-job", Kind: metadata.KindContainer}
+	requestBody, err := json.Marshal(jobMetadata)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/jobs/metadata", bytes.NewReader(requestBody))
+
+	suite.mockStore.On("Save", jobMetadata.Name, &jobMetadata).Return(nil)
+
+	suite.handler.Post()(responseRecorder, req)
+
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+	suite.mockStore.AssertExpectations(t)
+}
+
+func (suite *MetadataHandlerTestSuite) TestPostRejectsRemoteMetadataMissingURL() {
+	t := suite.T()
+
+	jobMetadata := metadata.Metadata{Name: "any-job", Kind: metadata.KindRemote, Remote: &metadata.RemoteMetadata{}}
+	requestBody, err := json.Marshal(jobMetadata)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/jobs/metadata", bytes.NewReader(requestBody))
+
+	suite.handler.Post()(responseRecorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	responseBody, _ := ioutil.ReadAll(responseRecorder.Body)
+	assert.Equal(t, utility.MissingRemoteURLClientError, string(responseBody))
+	suite.mockStore.AssertNotCalled(t, "Save")
+}
+
+func (suite *MetadataHandlerTestSuite) TestPostSavesValidRemoteMetadata() {
+	t := suite.T()
+
+	jobMetadata := metadata.Metadata{Name: "any-job", Kind: metadata.KindRemote, Remote: &metadata.RemoteMetadata{URL: "http://example.com"}}
+	requestBody, err := json.Marshal(jobMetadata)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/jobs/metadata", bytes.NewReader(requestBody))
+
+	suite.mockStore.On("Save", jobMetadata.Name, &jobMetadata).Return(nil)
+
+	suite.handler.Post()(responseRecorder, req)
+
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+	suite.mockStore.AssertExpectations(t)
+}
+
+func TestMetadataHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(MetadataHandlerTestSuite))
+}
@@ -0,0 +1,81 @@
+package metadata
+
+import (
+	"errors"
+	"strings"
+
+	"proctor/internal/app/proctord/utility"
+)
+
+// Store persists and retrieves the metadata registered for a proc.
+type Store interface {
+	GetJobMetadata(jobName string) (*Metadata, error)
+	Save(jobName string, metadata *Metadata) error
+	GetAll() (map[string]*Metadata, error)
+}
+
+// Kind distinguishes how a job's metadata should be interpreted when it is
+// executed: as a container run inside Kubernetes, or as a remote HTTP call.
+type Kind string
+
+const (
+	// KindContainer is the default, pre-existing behaviour: run the proc's
+	// image as a Kubernetes Job.
+	KindContainer Kind = "container"
+	// KindRemote invokes an HTTP webhook instead of scheduling a pod.
+	KindRemote Kind = "remote"
+)
+
+// Metadata describes everything proctord needs to know about a proc in order
+// to execute it, independent of any particular invocation.
+type Metadata struct {
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Contributors string          `json:"contributors"`
+	Organization string          `json:"organization"`
+	ImageName    string          `json:"image_name"`
+	AuthorEmail  string          `json:"author_email"`
+	Kind         Kind            `json:"kind"`
+	Remote       *RemoteMetadata `json:"remote,omitempty"`
+	// ResourceLimits caps what POST /jobs/execute/replicas may request for
+	// this proc. Nil means no limit is enforced.
+	ResourceLimits *ResourceLimits `json:"resource_limits,omitempty"`
+}
+
+// ResourceLimits is the per-image ceiling a replicas request is validated
+// against: Kubernetes-style quantities (e.g. "2", "512Mi") for CPU/memory/GPU,
+// plus a cap on how many replicas may be requested at once.
+type ResourceLimits struct {
+	MaxCPU      string `json:"max_cpu"`
+	MaxMemory   string `json:"max_memory"`
+	MaxGPU      string `json:"max_gpu"`
+	MaxReplicas int    `json:"max_replicas"`
+}
+
+// RemoteMetadata is only populated when Kind == KindRemote and describes the
+// HTTP call to make in place of a Kubernetes Job.
+type RemoteMetadata struct {
+	URL                   string            `json:"url"`
+	Method                string            `json:"method"`
+	Headers               map[string]string `json:"headers"`
+	Body                  string            `json:"body"`
+	TimeoutSeconds        int               `json:"timeout_seconds"`
+	ExpectedResponseCodes []int             `json:"expected_response_codes"`
+}
+
+// ValidateRemote rejects the metadata an execution.Executioner would refuse
+// to run: called both at POST /jobs/metadata registration time (so a broken
+// remote proc 400s immediately instead of on its first execute attempt) and
+// again by the executioner before it dispatches.
+func ValidateRemote(remote *RemoteMetadata) error {
+	if remote == nil || strings.TrimSpace(remote.URL) == "" {
+		return errors.New(utility.MissingRemoteURLClientError)
+	}
+
+	switch strings.ToUpper(remote.Method) {
+	case "", "GET", "POST", "PUT", "PATCH", "DELETE":
+		return nil
+	default:
+		return errors.New(utility.InvalidRemoteMethodClientError)
+	}
+}
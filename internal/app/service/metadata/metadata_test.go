@@ -0,0 +1,17 @@
+package metadata
+
+import "testing"
+
+func TestValidateRemote(t *testing.T) {
+	if err := ValidateRemote(nil); err == nil {
+		t.Fatalf("expected error for nil remote metadata")
+	}
+
+	if err := ValidateRemote(&RemoteMetadata{URL: "http://example.com", Method: "TRACE"}); err == nil {
+		t.Fatalf("expected error for unsupported method")
+	}
+
+	if err := ValidateRemote(&RemoteMetadata{URL: "http://example.com", Method: "post"}); err != nil {
+		t.Fatalf("expected valid remote metadata, got %v", err)
+	}
+}
@@ -0,0 +1,26 @@
+package metadata
+
+import "github.com/stretchr/testify/mock"
+
+// MockStore is a testify mock of Store, for handler tests that shouldn't
+// need a real Redis connection.
+type MockStore struct {
+	mock.Mock
+}
+
+func (m *MockStore) GetJobMetadata(jobName string) (*Metadata, error) {
+	arguments := m.Called(jobName)
+	metadata, _ := arguments.Get(0).(*Metadata)
+	return metadata, arguments.Error(1)
+}
+
+func (m *MockStore) Save(jobName string, metadata *Metadata) error {
+	arguments := m.Called(jobName, metadata)
+	return arguments.Error(0)
+}
+
+func (m *MockStore) GetAll() (map[string]*Metadata, error) {
+	arguments := m.Called()
+	all, _ := arguments.Get(0).(map[string]*Metadata)
+	return all, arguments.Error(1)
+}
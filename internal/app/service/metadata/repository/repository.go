@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"proctor/internal/app/service/infra/db/redis"
+	"proctor/internal/app/service/metadata"
+
+	goRedis "github.com/gomodule/redigo/redis"
+)
+
+const metadataKeyPrefix = "metadata"
+
+type metadataRepository struct {
+	redisClient redis.Client
+}
+
+func NewMetadataRepository(redisClient redis.Client) metadata.Store {
+	return &metadataRepository{redisClient: redisClient}
+}
+
+func (repository *metadataRepository) GetJobMetadata(jobName string) (*metadata.Metadata, error) {
+	conn := repository.redisClient.Get()
+	defer conn.Close()
+
+	raw, err := goRedis.Bytes(conn.Do("GET", fmt.Sprintf("%s:%s", metadataKeyPrefix, jobName)))
+	if err != nil {
+		return nil, err
+	}
+
+	var jobMetadata metadata.Metadata
+	if err := json.Unmarshal(raw, &jobMetadata); err != nil {
+		return nil, err
+	}
+
+	return &jobMetadata, nil
+}
+
+func (repository *metadataRepository) Save(jobName string, jobMetadata *metadata.Metadata) error {
+	conn := repository.redisClient.Get()
+	defer conn.Close()
+
+	raw, err := json.Marshal(jobMetadata)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("SET", fmt.Sprintf("%s:%s", metadataKeyPrefix, jobName), raw)
+	return err
+}
+
+func (repository *metadataRepository) GetAll() (map[string]*metadata.Metadata, error) {
+	conn := repository.redisClient.Get()
+	defer conn.Close()
+
+	keys, err := goRedis.Strings(conn.Do("KEYS", fmt.Sprintf("%s:*", metadataKeyPrefix)))
+	if err != nil {
+		return nil, err
+	}
+
+	all := make(map[string]*metadata.Metadata, len(keys))
+	for _, key := range keys {
+		raw, err := goRedis.Bytes(conn.Do("GET", key))
+		if err != nil {
+			return nil, err
+		}
+
+		var jobMetadata metadata.Metadata
+		if err := json.Unmarshal(raw, &jobMetadata); err != nil {
+			return nil, err
+		}
+		all[jobMetadata.Name] = &jobMetadata
+	}
+
+	return all, nil
+}
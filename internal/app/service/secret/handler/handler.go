@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"proctor/internal/app/proctord/utility"
+	"proctor/internal/app/service/secret"
+)
+
+type secretHttpHandler struct {
+	store secret.Store
+}
+
+func NewSecretHttpHandler(store secret.Store) *secretHttpHandler {
+	return &secretHttpHandler{store: store}
+}
+
+func (handler *secretHttpHandler) Post() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		jobName := req.URL.Query().Get("job_name")
+
+		secrets := map[string]string{}
+		if err := json.NewDecoder(req.Body).Decode(&secrets); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(utility.ClientError))
+			return
+		}
+
+		if err := handler.store.Save(jobName, secrets); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(utility.ServerError))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}
+}
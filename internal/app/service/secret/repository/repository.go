@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"proctor/internal/app/service/infra/db/redis"
+	"proctor/internal/app/service/secret"
+
+	goRedis "github.com/gomodule/redigo/redis"
+)
+
+const secretKeyPrefix = "secret"
+
+type secretRepository struct {
+	redisClient redis.Client
+}
+
+func NewSecretRepository(redisClient redis.Client) secret.Store {
+	return &secretRepository{redisClient: redisClient}
+}
+
+func (repository *secretRepository) GetJobSecrets(jobName string) (map[string]string, error) {
+	conn := repository.redisClient.Get()
+	defer conn.Close()
+
+	raw, err := goRedis.Bytes(conn.Do("GET", fmt.Sprintf("%s:%s", secretKeyPrefix, jobName)))
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := map[string]string{}
+	if err := json.Unmarshal(raw, &secrets); err != nil {
+		return nil, err
+	}
+
+	return secrets, nil
+}
+
+func (repository *secretRepository) Save(jobName string, secrets map[string]string) error {
+	conn := repository.redisClient.Get()
+	defer conn.Close()
+
+	raw, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("SET", fmt.Sprintf("%s:%s", secretKeyPrefix, jobName), raw)
+	return err
+}
@@ -0,0 +1,8 @@
+package secret
+
+// Store persists the per-job secrets injected into a proc's environment at
+// execution time.
+type Store interface {
+	GetJobSecrets(jobName string) (map[string]string, error)
+	Save(jobName string, secrets map[string]string) error
+}
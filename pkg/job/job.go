@@ -0,0 +1,74 @@
+package job
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	uuid "github.com/google/uuid"
+)
+
+// Type names which backing store a Job's GUID should be resolved against.
+type Type string
+
+const (
+	TypeExecute        Type = "execute"
+	TypeScheduleDelete Type = "schedule_delete"
+	TypeScheduleApply  Type = "schedule_apply"
+	TypeBulkApply      Type = "bulk_apply"
+)
+
+func (t Type) valid() bool {
+	switch t {
+	case TypeExecute, TypeScheduleDelete, TypeScheduleApply, TypeBulkApply:
+		return true
+	default:
+		return false
+	}
+}
+
+// State is the lifecycle stage of a long-running control-plane operation.
+type State string
+
+const (
+	StatePending   State = "pending"
+	StateRunning   State = "running"
+	StateSucceeded State = "succeeded"
+	StateFailed    State = "failed"
+)
+
+// Job is the uniform envelope GET /jobs/{guid} returns regardless of which
+// backing store (execution auditor, schedule store, bulk-apply store) the
+// GUID's type prefix routes it to.
+type Job struct {
+	GUID         string    `json:"guid"`
+	Type         Type      `json:"type"`
+	ResourceGUID string    `json:"resource_guid"`
+	State        State     `json:"state"`
+	Errors       []string  `json:"errors,omitempty"`
+	Warnings     []string  `json:"warnings,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// NewGUID mints a "<type>.<uuid>" GUID for a newly created resource of kind
+// typ, e.g. "bulk_apply.3fa9c1d2-...".
+func NewGUID(typ Type) string {
+	return fmt.Sprintf("%s.%s", typ, uuid.New().String())
+}
+
+// ParseGUID splits a "<type>.<uuid>" GUID back into its Type and the
+// resource identifier clients and stores actually key on.
+func ParseGUID(guid string) (Type, string, error) {
+	separator := strings.LastIndex(guid, ".")
+	if separator == -1 {
+		return "", "", fmt.Errorf("malformed job guid %q", guid)
+	}
+
+	typ := Type(guid[:separator])
+	if !typ.valid() {
+		return "", "", fmt.Errorf("unknown job type %q in guid %q", typ, guid)
+	}
+
+	return typ, guid[separator+1:], nil
+}